@@ -0,0 +1,88 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+//go:build !race
+
+package feeds_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// TestAllocationBudget guards against regressions in the number of allocations made on the hot paths of decoding a
+// feed. The budgets have some headroom over the measured allocations; if a change legitimately increases them, the
+// budget should be updated alongside the change. It is not built with the race detector, which changes the number of
+// allocations made.
+func TestAllocationBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation budget in short mode")
+	}
+	small := corpusSample(t, "small-rss")
+	jsonFeed := corpusSample(t, "jsonfeed")
+
+	tests := []struct {
+		name   string
+		budget float64
+		run    func()
+	}{
+		{
+			name:   "decode small rss",
+			budget: 1850,
+			run: func() {
+				_, _ = decodeSample(small)
+			},
+		},
+		{
+			name:   "decode jsonfeed",
+			budget: 3600,
+			run: func() {
+				_, _ = decodeSample(jsonFeed)
+			},
+		},
+		{
+			name:   "stream small rss",
+			budget: 1800,
+			run: func() {
+				for _, err := range feeds.DecodeItems(bytes.NewReader(small.Data), small.Format) {
+					if err != nil {
+						return
+					}
+				}
+			},
+		},
+		{
+			name:   "parse rfc822 date",
+			budget: 2,
+			run: func() {
+				_, _ = rss.ParseRFC822("Mon, 02 Jan 2006 15:04:05 -0700")
+			},
+		},
+	}
+	for tt := range slices.Values(tests) {
+		t.Run(tt.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(20, tt.run)
+			t.Logf("%s: %.0f allocs/op", tt.name, allocs)
+			assert.LessOrEqual(t, allocs, tt.budget)
+		})
+	}
+}
+
+// corpusSample returns the standard corpus sample with the given name.
+func corpusSample(t *testing.T, name string) corpus.Sample {
+	t.Helper()
+	samples := corpus.Samples()
+	idx := slices.IndexFunc(samples, func(sample corpus.Sample) bool {
+		return sample.Name == name
+	})
+	require.NotEqual(t, -1, idx, "no corpus sample named %q", name)
+	return samples[idx]
+}
@@ -16,12 +16,19 @@ import (
 // Defines values for LinkRel.
 const (
 	LinkRelAlternate                     LinkRel = "alternate"
+	LinkRelCurrent                       LinkRel = "current"
 	LinkRelEdit                          LinkRel = "edit"
 	LinkRelEnclosure                     LinkRel = "enclosure"
+	LinkRelFirst                         LinkRel = "first"
 	LinkRelHttpschemasGoogleComg2005Feed LinkRel = "http://schemas.google.com/g/2005#feed"
 	LinkRelHub                           LinkRel = "hub"
+	LinkRelLast                          LinkRel = "last"
 	LinkRelNext                          LinkRel = "next"
+	LinkRelNextArchive                   LinkRel = "next-archive"
+	LinkRelPrevArchive                   LinkRel = "prev-archive"
+	LinkRelPrevious                      LinkRel = "previous"
 	LinkRelRelated                       LinkRel = "related"
+	LinkRelReplies                       LinkRel = "replies"
 	LinkRelSelf                          LinkRel = "self"
 	LinkRelStandout                      LinkRel = "standout"
 	LinkRelVia                           LinkRel = "via"
@@ -32,18 +39,32 @@ func (e LinkRel) Valid() bool {
 	switch e {
 	case LinkRelAlternate:
 		return true
+	case LinkRelCurrent:
+		return true
 	case LinkRelEdit:
 		return true
 	case LinkRelEnclosure:
 		return true
+	case LinkRelFirst:
+		return true
 	case LinkRelHttpschemasGoogleComg2005Feed:
 		return true
 	case LinkRelHub:
 		return true
+	case LinkRelLast:
+		return true
 	case LinkRelNext:
 		return true
+	case LinkRelNextArchive:
+		return true
+	case LinkRelPrevArchive:
+		return true
+	case LinkRelPrevious:
+		return true
 	case LinkRelRelated:
 		return true
+	case LinkRelReplies:
+		return true
 	case LinkRelSelf:
 		return true
 	case LinkRelStandout:
@@ -316,20 +337,20 @@ type Entry struct {
 	Relation *externalRef1.Relation `json:"relation,omitempty" xml:"http://purl.org/dc/elements/1.1/ relation,omitempty"`
 
 	// Rights is an element of type Text construct that conveys information about rights held in and over an entry or feed.
-	Rights *Rights `json:"rights,omitempty" xml:"rights,omitempty"`
+	Rights *Rights `json:"rights,omitempty" validate:"omitempty,validateFn" xml:"rights,omitempty"`
 
 	// Source contains the metadata from the source feed for the entry.
-	Source *Source `json:"source,omitempty" validate:"omitempty"`
+	Source *Source `json:"source,omitempty" validate:"omitempty,structonly" xml:"source,omitempty"`
 
 	// Subject is a topic of the resource.
 	// Recommended practice is to refer to the subject with a URI. If this is not possible or feasible, a literal value that identifies the subject may be provided. Both should preferably refer to a subject in a controlled vocabulary.
 	Subject *externalRef1.Subject `json:"subject,omitempty" xml:"http://purl.org/dc/elements/1.1/ subject,omitempty"`
 
 	// Summary is an element of type Text construct that conveys a short summary, abstract, or excerpt of an entry.
-	Summary *Summary `json:"summary,omitempty" xml:"summary,omitempty"`
+	Summary *Summary `json:"summary,omitempty" validate:"omitempty,validateFn" xml:"summary,omitempty"`
 
 	// Title is an element of type Text construct that conveys a human-readable title for an entry or feed.
-	Title Title `json:"title" validate:"required" xml:"title"`
+	Title Title `json:"title" validate:"required,validateFn" xml:"title"`
 
 	// Type is the nature or genre of the resource.
 	// Recommended practice is to use a controlled vocabulary such as the DCMI Type Vocabulary [DCMI-TYPE]. To describe the file format, physical medium, or dimensions of the resource, use the property Format.
@@ -499,7 +520,7 @@ type Feed struct {
 	Relation *externalRef1.Relation `json:"relation,omitempty" xml:"http://purl.org/dc/elements/1.1/ relation,omitempty"`
 
 	// Rights is an element of type Text construct that conveys information about rights held in and over an entry or feed.
-	Rights *Rights `json:"rights,omitempty" xml:"rights,omitempty"`
+	Rights *Rights `json:"rights,omitempty" validate:"omitempty,validateFn" xml:"rights,omitempty"`
 
 	// Source is a related resource from which the described resource is derived.
 	// This property is intended to be used with non-literal values. The described resource may be derived from the related resource in whole or in part. Best practice is to identify the related resource by means of a URI or a string conforming to a formal identification system.
@@ -510,10 +531,10 @@ type Feed struct {
 	Subject *externalRef1.Subject `json:"subject,omitempty" xml:"http://purl.org/dc/elements/1.1/ subject,omitempty"`
 
 	// Subtitle is an element of type Text construct that conveys a human-readable subtitle for an entry or feed.
-	Subtitle *Subtitle `json:"subtitle,omitempty" xml:"subtitle,omitempty"`
+	Subtitle *Subtitle `json:"subtitle,omitempty" validate:"omitempty,validateFn" xml:"subtitle,omitempty"`
 
 	// Title is an element of type Text construct that conveys a human-readable title for an entry or feed.
-	Title Title `json:"title" validate:"required" xml:"title"`
+	Title Title `json:"title" validate:"required,validateFn" xml:"title"`
 
 	// Type is the nature or genre of the resource.
 	// Recommended practice is to use a controlled vocabulary such as the DCMI Type Vocabulary [DCMI-TYPE]. To describe the file format, physical medium, or dimensions of the resource, use the property Format.
@@ -562,13 +583,13 @@ type FeedMetadata struct {
 	Published *Published `json:"published,omitempty" xml:"published,omitempty"`
 
 	// Rights is an element of type Text construct that conveys information about rights held in and over an entry or feed.
-	Rights *Rights `json:"rights,omitempty" xml:"rights,omitempty"`
+	Rights *Rights `json:"rights,omitempty" validate:"omitempty,validateFn" xml:"rights,omitempty"`
 
 	// Subtitle is an element of type Text construct that conveys a human-readable subtitle for an entry or feed.
-	Subtitle *Subtitle `json:"subtitle,omitempty" xml:"subtitle,omitempty"`
+	Subtitle *Subtitle `json:"subtitle,omitempty" validate:"omitempty,validateFn" xml:"subtitle,omitempty"`
 
 	// Title is an element of type Text construct that conveys a human-readable title for an entry or feed.
-	Title Title `json:"title" validate:"required" xml:"title"`
+	Title Title `json:"title" validate:"required,validateFn" xml:"title"`
 
 	// Updated is an element of type Date construct indicating the most recent instant in time when an entry or feed was modified in a way the publisher considers significant.
 	Updated Updated `json:"updated" validate:"required" xml:"updated"`
@@ -645,7 +666,7 @@ type Link struct {
 	Length *int `json:"length,omitempty" validate:"omitempty,number" xml:"length,attr,omitempty"`
 
 	// Rel contains a keyword that identifies the nature of the relationship between the linked resouce and the element.
-	Rel LinkRel `json:"rel,omitempty" validate:"omitempty,oneof=alternate enclosure related self via hub edit next standout http://schemas.google.com/g/2005#feed" xml:"rel,attr,omitempty"`
+	Rel LinkRel `json:"rel,omitempty" validate:"omitempty,oneof=alternate enclosure related self via hub edit next standout replies previous first last current prev-archive next-archive http://schemas.google.com/g/2005#feed" xml:"rel,attr,omitempty"`
 
 	// Title provides a human-readable description of the resource.
 	Title *string `json:"title,omitempty" xml:"title,attr,omitempty"`
@@ -691,7 +712,10 @@ type PersonConstruct struct {
 	Email *string `json:"email,omitempty" validate:"omitempty,email" xml:"email,omitempty"`
 
 	// Name is an element that conveys a human-readable name.
-	Name string `json:"name" validate:"required" xml:"name"`
+	Name string `json:"name" validate:"required,nohtml" xml:"name"`
+
+	// RepeatedElements lists the child elements that appeared more than once when the person was decoded, which is not allowed. Only the first value of each is kept, and validation fails.
+	RepeatedElements []string `json:"-" validate:"max=0" xml:"-"`
 
 	// URI is an element that conveys an IRI (URI).
 	URI *string `json:"uri,omitempty" validate:"omitempty" xml:"uri,omitempty"`
@@ -860,20 +884,20 @@ type StandaloneEntry struct {
 	Relation *externalRef1.Relation `json:"relation,omitempty" xml:"http://purl.org/dc/elements/1.1/ relation,omitempty"`
 
 	// Rights is an element of type Text construct that conveys information about rights held in and over an entry or feed.
-	Rights *Rights `json:"rights,omitempty" xml:"rights,omitempty"`
+	Rights *Rights `json:"rights,omitempty" validate:"omitempty,validateFn" xml:"rights,omitempty"`
 
 	// Source contains the metadata from the source feed for the entry.
-	Source *Source `json:"source,omitempty" validate:"omitempty"`
+	Source *Source `json:"source,omitempty" validate:"omitempty,structonly" xml:"source,omitempty"`
 
 	// Subject is a topic of the resource.
 	// Recommended practice is to refer to the subject with a URI. If this is not possible or feasible, a literal value that identifies the subject may be provided. Both should preferably refer to a subject in a controlled vocabulary.
 	Subject *externalRef1.Subject `json:"subject,omitempty" xml:"http://purl.org/dc/elements/1.1/ subject,omitempty"`
 
 	// Summary is an element of type Text construct that conveys a short summary, abstract, or excerpt of an entry.
-	Summary *Summary `json:"summary,omitempty" xml:"summary,omitempty"`
+	Summary *Summary `json:"summary,omitempty" validate:"omitempty,validateFn" xml:"summary,omitempty"`
 
 	// Title is an element of type Text construct that conveys a human-readable title for an entry or feed.
-	Title Title `json:"title" validate:"required" xml:"title"`
+	Title Title `json:"title" validate:"required,validateFn" xml:"title"`
 
 	// Type is the nature or genre of the resource.
 	// Recommended practice is to use a controlled vocabulary such as the DCMI Type Vocabulary [DCMI-TYPE]. To describe the file format, physical medium, or dimensions of the resource, use the property Format.
@@ -14,11 +14,16 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/immanent-tech/go-syndication/extensions/dc"
 	"github.com/immanent-tech/go-syndication/sanitization"
 	"github.com/immanent-tech/go-syndication/validation"
 )
 
-const atomNS = "http://www.w3.org/1999/xhtml"
+const (
+	atomNS  = "http://www.w3.org/2005/Atom"
+	xhtmlNS = "http://www.w3.org/1999/xhtml"
+	xmlNS   = "http://www.w3.org/XML/1998/namespace"
+)
 
 // dateLayout mirrors time.RFC3339Nano: "2006-01-02T15:04:05.999999999Z07:00". The trailing ".999999999" is Go's
 // convention for "trim trailing zero fractional digits, omit entirely if zero". This naturally produces the spec's
@@ -58,6 +63,59 @@ func (p PersonConstruct) String() string {
 	return value.String()
 }
 
+// contributorPersons returns the given <contributor> person constructs followed by a person construct, with only a
+// name, for each of the given <dc:contributor> values.
+func contributorPersons(contributors Contributors, dcContributors *dc.Contributor) []PersonConstruct {
+	var persons []PersonConstruct
+	persons = append(persons, contributors...)
+	if dcContributors != nil {
+		for name := range slices.Values(*dcContributors) {
+			persons = append(persons, PersonConstruct{Name: name})
+		}
+	}
+	return persons
+}
+
+// UnmarshalXML implements xml.Unmarshaler. A person construct may contain at most one each of the <name>, <uri> and
+// <email> elements. Rather than silently keeping the last of any that are repeated, the first is kept and the element
+// is recorded in RepeatedElements, so that validation of the PersonConstruct fails.
+func (p *PersonConstruct) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	// person has the fields of PersonConstruct but not this method, to avoid recursion.
+	type person PersonConstruct
+	var shadow struct {
+		person
+
+		Names  []string `xml:"name"`
+		URIs   []string `xml:"uri"`
+		Emails []string `xml:"email"`
+	}
+	if err := dec.DecodeElement(&shadow, &start); err != nil {
+		return fmt.Errorf("person: unmarshal: %w", err)
+	}
+	*p = PersonConstruct(shadow.person)
+	if len(shadow.Names) > 0 {
+		p.Name = shadow.Names[0]
+	}
+	if len(shadow.URIs) > 0 {
+		p.URI = &shadow.URIs[0]
+	}
+	if len(shadow.Emails) > 0 {
+		p.Email = &shadow.Emails[0]
+	}
+	for element, values := range map[string][]string{"name": shadow.Names, "uri": shadow.URIs, "email": shadow.Emails} {
+		if len(values) > 1 {
+			p.RepeatedElements = append(p.RepeatedElements, element)
+		}
+	}
+	slices.Sort(p.RepeatedElements)
+	return nil
+}
+
+// NewCategory creates a Category with the given term.
+func NewCategory(term string) Category {
+	return Category{Term: xml.Attr{Name: xml.Name{Local: "term"}, Value: term}}
+}
+
 // String returns the string-ified format of the Category. It will return the first found of: any human-readable label,
 // the element value or the term attribute value, in that order.
 func (c Category) String() string {
@@ -82,6 +140,28 @@ func (c Category) String() string {
 	return ""
 }
 
+// GetScheme returns the scheme attribute of the Category, which identifies its categorization scheme (taxonomy), or an
+// empty string if it has none.
+func (c Category) GetScheme() string {
+	if c.Scheme == nil {
+		return ""
+	}
+	return strings.TrimSpace(c.Scheme.Value)
+}
+
+// FilterCategoriesByScheme returns the categories with the given scheme. Schemes are IRIs, so they are compared as is.
+// An empty scheme returns the categories without a scheme.
+func FilterCategoriesByScheme(categories Categories, scheme string) []Category {
+	scheme = strings.TrimSpace(scheme)
+	var filtered []Category
+	for category := range slices.Values(categories) {
+		if category.GetScheme() == scheme {
+			filtered = append(filtered, category)
+		}
+	}
+	return filtered
+}
+
 // String formats the generator value as a string in the format VALUE[/VERSION] [(URI)].
 func (g Generator) String() string {
 	var gen strings.Builder
@@ -133,6 +213,9 @@ func (l *Link) Validate() error {
 	return nil
 }
 
+// String returns the value of the text construct according to its type: the text of a text construct, the (decoded)
+// markup of a html construct and the markup inside the <div> of an xhtml construct, without namespace prefixes. This
+// matches the treatment of Content.
 func (t TextConstruct) String() string {
 	switch {
 	case t.Type == nil || (*t.Type != TypeXhtml && !isXMLMediaType(*t.Type)):
@@ -177,7 +260,7 @@ func (t TextConstruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) erro
 			Inner   string   `xml:",innerxml"`
 		}{
 			XMLName: xml.Name{Local: "div"},
-			XMLNS:   atomNS,
+			XMLNS:   xhtmlNS,
 			Inner:   *t.XHTML,
 		}
 		if err := enc.Encode(div); err != nil {
@@ -214,16 +297,11 @@ func (t *TextConstruct) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) e
 	t.Type = new(typ)
 
 	if typ == TypeXhtml {
-		var wrapper struct {
-			Div struct {
-				Inner string `xml:",innerxml"`
-			} `xml:"div"` // matches any namespace's local-name "div"
-		}
-
-		if err := dec.DecodeElement(&wrapper, &start); err != nil {
+		xhtml, err := decodeXHTML(dec)
+		if err != nil {
 			return fmt.Errorf("text construct: unmarshal: %w", err)
 		}
-		t.XHTML = new(strings.TrimSpace(wrapper.Div.Inner))
+		t.XHTML = &xhtml
 		return nil
 	}
 	// Leniency for non-conformant producers that put a MIME type here that really belongs on atom:content (e.g.
@@ -241,14 +319,29 @@ func (t *TextConstruct) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) e
 	}
 
 	// "text" and "html" (and, leniently, anything else): plain character data. The decoder already unescapes entities
-	// for us, so for "html" content this correctly yields real markup back as a Go string.
+	// and CDATA sections for us, so for "html" content this correctly yields real markup back as a Go string, however
+	// it was escaped. Leniently, markup that was not escaped at all is kept as is, rather than dropped.
 	var valueStruct struct {
-		Value string `xml:",chardata"`
+		Value    string     `xml:",chardata"`
+		Inner    string     `xml:",innerxml"`
+		Elements []struct{} `xml:",any"`
 	}
 	if err := dec.DecodeElement(&valueStruct, &start); err != nil {
 		return fmt.Errorf("text construct: unmarshal: %w", err)
 	}
 	t.Value = valueStruct.Value
+	if len(valueStruct.Elements) > 0 {
+		t.Value = valueStruct.Inner
+	}
+	return nil
+}
+
+// Validate checks that a text construct of type text does not contain HTML markup, as markup must be declared with a
+// type of html or xhtml. Markup escaped in a CDATA section is still markup.
+func (t *TextConstruct) Validate() error {
+	if (t.Type == nil || *t.Type == TypeText) && validation.ContainsHTML(t.Value) {
+		return fmt.Errorf("text construct: %w: type text contains HTML", validation.ErrInvalidField)
+	}
 	return nil
 }
 
@@ -331,6 +424,82 @@ func (d *DateConstruct) Validate() error {
 	return nil
 }
 
+// voidElements are the HTML elements that have no content or end tag.
+var voidElements = []string{
+	"area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "source", "track", "wbr",
+}
+
+// xhtmlEscaper escapes text and attribute values when serializing XHTML.
+var xhtmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// decodeXHTML decodes the remainder of an element of type xhtml, whose content is a single XHTML <div>. The markup
+// inside the div is returned, serialized without namespace prefixes or declarations (e.g., <xhtml:em> becomes <em>),
+// so that it can be used as HTML and encoded again inside a <div> declaring the XHTML namespace.
+func decodeXHTML(dec *xml.Decoder) (string, error) {
+	// loose is any text outside the <div>, used as is if there is no <div>, such as XHTML escaped in a CDATA section.
+	var out, loose strings.Builder
+	// depth is the depth of the current token within the element, where the <div> is at depth 1.
+	depth := 0
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return "", fmt.Errorf("decode xhtml: %w", err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth > 1 {
+				writeXHTMLStart(&out, token)
+			}
+		case xml.EndElement:
+			if depth == 0 {
+				if out.Len() == 0 {
+					return strings.TrimSpace(loose.String()), nil
+				}
+				return strings.TrimSpace(out.String()), nil
+			}
+			if depth > 1 && !slices.Contains(voidElements, token.Name.Local) {
+				out.WriteString("</" + token.Name.Local + ">")
+			}
+			depth--
+		case xml.CharData:
+			if depth > 0 {
+				out.WriteString(xhtmlEscaper.Replace(string(token)))
+			} else {
+				loose.Write(token)
+			}
+		case xml.Comment:
+			if depth > 0 {
+				out.WriteString("<!--" + string(token) + "-->")
+			}
+		}
+	}
+}
+
+// writeXHTMLStart writes the given start tag of an XHTML element, without namespace prefixes or declarations.
+func writeXHTMLStart(out *strings.Builder, start xml.StartElement) {
+	out.WriteString("<" + start.Name.Local)
+	for attr := range slices.Values(start.Attr) {
+		name := attr.Name.Local
+		switch attr.Name.Space {
+		case "xmlns":
+			continue
+		case "":
+			if name == "xmlns" {
+				continue
+			}
+		case "xml", xmlNS:
+			name = "xml:" + name
+		}
+		out.WriteString(" " + name + `="` + xhtmlEscaper.Replace(attr.Value) + `"`)
+	}
+	if slices.Contains(voidElements, start.Name.Local) {
+		out.WriteString("/>")
+		return
+	}
+	out.WriteString(">")
+}
+
 func isXMLMediaType(t Type) bool {
 	return t != TypeText && t != TypeHtml && t != TypeXhtml &&
 		!strings.HasPrefix(string(t), "text/") &&
@@ -416,15 +585,11 @@ func (c *Content) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
 
 	switch {
 	case typ == TypeXhtml:
-		var wrapper struct {
-			Div struct {
-				Inner string `xml:",innerxml"`
-			} `xml:"div"`
-		}
-		if err := dec.DecodeElement(&wrapper, &start); err != nil {
+		xhtml, err := decodeXHTML(dec)
+		if err != nil {
 			return err
 		}
-		c.XHTML = new(strings.TrimSpace(wrapper.Div.Inner))
+		c.XHTML = &xhtml
 		return nil
 	case typ == TypeText || typ == TypeHtml || strings.HasPrefix(string(typ), "text/"):
 		var v struct {
@@ -489,3 +654,31 @@ func (c Content) RequiresSummary() bool {
 	}
 	return !isXMLMediaType(typ) // i.e. it's the Base64 branch
 }
+
+// MarshalXML implements xml.Marshaler. FeedMetadata is the content of the <source> element of an entry, in which
+// (unlike a feed) the <id>, <title> and <updated> elements are optional, so any without a value are omitted rather than
+// encoded as invalid empty elements.
+func (m FeedMetadata) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	// metadata has the fields of FeedMetadata but not this method, to avoid recursion.
+	type metadata FeedMetadata
+	shadow := struct {
+		metadata
+
+		ID      *ID      `xml:"id,omitempty"`
+		Title   *Title   `xml:"title,omitempty"`
+		Updated *Updated `xml:"updated,omitempty"`
+	}{metadata: metadata(m)}
+	if m.ID.Value != "" {
+		shadow.ID = &m.ID
+	}
+	if m.Title.Value != "" || m.Title.XHTML != nil {
+		shadow.Title = &m.Title
+	}
+	if !m.Updated.Value.IsZero() {
+		shadow.Updated = &m.Updated
+	}
+	if err := enc.EncodeElement(shadow, start); err != nil {
+		return fmt.Errorf("source: marshal: %w", err)
+	}
+	return nil
+}
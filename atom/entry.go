@@ -18,6 +18,7 @@ import (
 )
 
 var _ types.ItemSource = (*Entry)(nil)
+var _ types.HasComments = (*Entry)(nil)
 
 // GetID returns an "id" for the Entry. This will be the value of the <id> element, if present, or an empty string if
 // not present.
@@ -45,6 +46,24 @@ func (e *Entry) GetLink() string {
 	return ""
 }
 
+// GetCommentsURL retrieves the URL of the comments (if any) on the Entry, from its rel="replies" links (RFC 4685). A
+// replies link of an HTML page is preferred over one of a feed of replies.
+func (e *Entry) GetCommentsURL() string {
+	var replies string
+	for link := range slices.Values(e.Links) {
+		if link.Rel != LinkRelReplies || link.Href == "" {
+			continue
+		}
+		if link.Type != nil && *link.Type == "text/html" {
+			return link.Href
+		}
+		if replies == "" {
+			replies = link.Href
+		}
+	}
+	return replies
+}
+
 // GetDescription retrieves the <summary> (if any) of the Entry.
 func (e *Entry) GetDescription() string {
 	switch {
@@ -89,6 +108,12 @@ func (e *Entry) GetContributors() []string {
 	return contributors
 }
 
+// GetContributorPersons retrieves the contributors of the Entry as person constructs, retaining any email and URI of
+// the <contributor> elements. Any <dc:contributor> values follow, as person constructs with only a name.
+func (e *Entry) GetContributorPersons() []PersonConstruct {
+	return contributorPersons(e.Contributors, e.Contributor)
+}
+
 // GetRights retrieves the rights (copyright) of the Entry. This will be the first value found from either <dc:rights>
 // or <rights> elements.
 func (e *Entry) GetRights() *string {
@@ -121,6 +146,12 @@ func (e *Entry) GetCategories() []string {
 	return slices.Compact(categories)
 }
 
+// GetCategoriesByScheme retrieves the <category> elements of the Entry with the given scheme. See
+// FilterCategoriesByScheme.
+func (e *Entry) GetCategoriesByScheme(scheme string) []Category {
+	return FilterCategoriesByScheme(e.Categories, scheme)
+}
+
 // GetImage retrieves the image (if any) for the Entry. The image is returned as a types.ImageInfo object.
 func (e *Entry) GetImage() *types.ImageInfo {
 	// Use the first <media:thumbnail>
@@ -142,11 +173,94 @@ func (e *Entry) GetImage() *types.ImageInfo {
 	return nil
 }
 
+// SetTitle sets the <title> of the Entry, as text.
+func (e *Entry) SetTitle(title string) {
+	e.Title = Title{Value: title}
+}
+
+// SetDescription sets the <summary> of the Entry, as HTML. Any <dc:description> is removed, as it would otherwise take
+// precedence.
+func (e *Entry) SetDescription(description string) {
+	e.Summary = &Summary{Type: new(TypeHtml), Value: description}
+	e.Description = nil
+}
+
+// SetLink sets the alternate <link> of the Entry, replacing the first existing alternate link, if any.
+func (e *Entry) SetLink(link string) {
+	e.Links = setAlternateLink(e.Links, link)
+}
+
+// SetPublishedDate sets the <published> date of the Entry. A zero date removes it.
+func (e *Entry) SetPublishedDate(date time.Time) {
+	if date.IsZero() {
+		e.Published = nil
+		return
+	}
+	e.Published = &Published{Value: date}
+}
+
+// AddCategory adds a <category> with the given term to the Entry.
+func (e *Entry) AddCategory(category string) {
+	e.Categories = append(e.Categories, NewCategory(category))
+}
+
+// setAlternateLink sets the href of the first alternate link (i.e., with a rel of "alternate" or no rel) in the given
+// links, or appends an alternate link if there is none.
+func setAlternateLink(links Links, href string) Links {
+	for idx := range links {
+		if links[idx].Rel == "" || links[idx].Rel == LinkRelAlternate {
+			links[idx].Href = href
+			return links
+		}
+	}
+	return append(links, Link{Href: href, Rel: LinkRelAlternate})
+}
+
 // GetMediaGroup returns any media.MediaGroup object for the entry.
 func (e *Entry) GetMediaGroup() *media.MediaGroup {
 	return e.MediaGroup
 }
 
+// GetMediaCredits returns the <media:credit> elements of the Entry, its <media:group> and the <media:content> elements
+// of the group. See media.CollectCredits.
+func (e *Entry) GetMediaCredits() media.MediaCredits {
+	return media.CollectCredits(e.MediaCredits, e.MediaGroup)
+}
+
+// GetMediaHashes returns the <media:hash> elements of the Entry, its <media:group> and the <media:content> elements of
+// the group. See media.CollectHashes.
+func (e *Entry) GetMediaHashes() media.MediaHashes {
+	return media.CollectHashes(e.MediaHashes, e.MediaGroup)
+}
+
+// GetMediaTexts returns the <media:text> elements of the Entry, its <media:group> and the <media:content> elements of
+// the group. See media.CollectTexts.
+func (e *Entry) GetMediaTexts() media.MediaTexts {
+	return media.CollectTexts(e.MediaTexts, e.MediaGroup)
+}
+
+// GetMediaCommunity returns the <media:community> of the Entry, merged with those of its <media:group> and the
+// <media:content> elements of the group. See media.CollectCommunity.
+func (e *Entry) GetMediaCommunity() *media.MediaCommunity {
+	return media.CollectCommunity(e.MediaCommunity, e.MediaGroup)
+}
+
+// VerifyEnclosureHash checks the given data, such as a downloaded enclosure, against the <media:hash> elements of the
+// Entry. See media.MediaHashes.Verify.
+func (e *Entry) VerifyEnclosureHash(data []byte) error {
+	if err := e.GetMediaHashes().Verify(data); err != nil {
+		return fmt.Errorf("verify enclosure: %w", err)
+	}
+	return nil
+}
+
+// GetOriginFeed returns the metadata of the feed the Entry was originally published in, from its <source> element (if
+// any). Entries copied from one feed into another, such as by an aggregator, carry a <source> element so that they can
+// be traced back to their origin feed. If the Entry has no <source> element, nil is returned.
+func (e *Entry) GetOriginFeed() *Source {
+	return e.Source
+}
+
 // GetPublishedDate returns the <published> of the Entry (if any). If there is no publish date, it will return a
 // DateTime equal to Unix epoch.
 func (e *Entry) GetPublishedDate() *time.Time {
@@ -6,13 +6,13 @@ package atom
 import (
 	"encoding/xml"
 	"fmt"
+	"iter"
 	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/immanent-tech/go-syndication/extensions"
-	"github.com/immanent-tech/go-syndication/extensions/media"
 	"github.com/immanent-tech/go-syndication/types"
 	"github.com/immanent-tech/go-syndication/validation"
 )
@@ -24,6 +24,7 @@ var (
 )
 
 var _ types.FeedSource = (*Feed)(nil)
+var _ types.HasIcon = (*Feed)(nil)
 
 // GetTitle retrieves the <title> of the Feed.
 func (f *Feed) GetTitle() string {
@@ -43,22 +44,32 @@ func (f *Feed) GetDescription() string {
 }
 
 // GetSourceURL retrieves the URL that links to the Atom file for the Feed. This will be any <link> element
-// present with a "rel" attribute of "self" and ideally with a mime-type indicating Atom content.
+// present with a "rel" attribute of "self" and ideally with a mime-type indicating Atom content. If there is no such
+// link, the first "self" link without a mime-type is used.
 func (f *Feed) GetSourceURL() string {
+	var untyped string
 	for link := range slices.Values(f.Links) {
 		if link.Rel != "" && link.Rel == LinkRelSelf {
 			if link.Type != nil && slices.Contains(types.MimeTypesAtom, *link.Type) {
 				return link.Href
 			}
+			if link.Type == nil && untyped == "" {
+				untyped = link.Href
+			}
 		}
 	}
-	return ""
+	return untyped
 }
 
-// SetSourceURL will set a source URL, indicating the URL of the Atom document, in the Feed.
+// SetSourceURL will set a source URL, indicating the URL of the Atom document, in the Feed. Any existing <link>
+// elements with a "rel" attribute of "self" that point to an Atom document, or do not say what they point to, are
+// replaced, so that setting the source URL repeatedly does not add more links. Other links, including "self" links to
+// other types of document, are kept.
 func (f *Feed) SetSourceURL(url string) {
-	rel := LinkRelSelf
-	f.Links = append(f.Links, Link{Href: url, Rel: rel, Type: &types.MimeTypesAtom[0]})
+	f.Links = slices.DeleteFunc(f.Links, func(link Link) bool {
+		return link.Rel == LinkRelSelf && (link.Type == nil || slices.Contains(types.MimeTypesAtom, *link.Type))
+	})
+	f.Links = slices.Insert(f.Links, 0, Link{Href: url, Rel: LinkRelSelf, Type: new(types.MimeTypesAtom[0])})
 }
 
 // GetLink retrieves the <link> of the Feed. This is the link to the website associated with the Atom feed. Even the
@@ -110,6 +121,12 @@ func (f *Feed) GetContributors() []string {
 	return contributors
 }
 
+// GetContributorPersons retrieves the contributors of the Feed as person constructs, retaining any email and URI of
+// the <contributor> elements. Any <dc:contributor> values follow, as person constructs with only a name.
+func (f *Feed) GetContributorPersons() []PersonConstruct {
+	return contributorPersons(f.Contributors, f.Contributor)
+}
+
 // GetRights retrieves the rights (copyright) of the Feed. This will be the first value found from either <dc:rights>
 // or <rights> elements.
 func (f *Feed) GetRights() *string {
@@ -141,8 +158,14 @@ func (f *Feed) GetCategories() []string {
 	return categories
 }
 
+// GetCategoriesByScheme retrieves the <category> elements of the Feed with the given scheme. See
+// FilterCategoriesByScheme.
+func (f *Feed) GetCategoriesByScheme(scheme string) []Category {
+	return FilterCategoriesByScheme(f.Categories, scheme)
+}
+
 // GetImage retrieves the image (if any) for the Feed. The image is returned as a types.ImageInfo object. The value will be
-// the first found of <media:thumbnail> element.
+// the <logo> element, if present, then the <icon> element, then the first <media:thumbnail> element.
 func (f *Feed) GetImage() *types.ImageInfo {
 	switch {
 	case f.Logo != nil:
@@ -166,13 +189,22 @@ func (f *Feed) GetImage() *types.ImageInfo {
 	}
 }
 
-// SetImage sets an image for the Channel.
-func (f *Feed) SetImage(image *types.ImageInfo) {
-	f.MediaThumbnails = media.MediaThumbnails{
-		media.MediaThumbnail{URL: image.GetURL()},
+// GetIcon retrieves the <icon> (if any) of the Feed. The image is returned as a types.ImageInfo object.
+func (f *Feed) GetIcon() *types.ImageInfo {
+	if f.Icon == nil || f.Icon.Value == "" {
+		return nil
+	}
+	return &types.ImageInfo{
+		URL:   f.Icon.Value,
+		Title: f.GetTitle(),
 	}
 }
 
+// SetImage sets the <logo> of the Feed.
+func (f *Feed) SetImage(image *types.ImageInfo) {
+	f.Logo = &Logo{Value: image.GetURL()}
+}
+
 // GetPublishedDate returns the <published> element of the Feed.
 func (f *Feed) GetPublishedDate() *time.Time {
 	if f.Published != nil {
@@ -181,6 +213,48 @@ func (f *Feed) GetPublishedDate() *time.Time {
 	return nil
 }
 
+// SetTitle sets the <title> of the Feed, as text.
+func (f *Feed) SetTitle(title string) {
+	f.Title = Title{Value: title}
+}
+
+// SetDescription sets the <subtitle> of the Feed, as text. Any <dc:description> is removed, as it would otherwise take
+// precedence.
+func (f *Feed) SetDescription(description string) {
+	f.Subtitle = &Subtitle{Value: description}
+	f.Description = nil
+}
+
+// SetLink sets the alternate <link> of the Feed, the link to the website associated with the Atom feed, replacing the
+// first existing alternate link, if any.
+func (f *Feed) SetLink(link string) {
+	f.Links = setAlternateLink(f.Links, link)
+}
+
+// SetPublishedDate sets the <published> date of the Feed. A zero date removes it.
+func (f *Feed) SetPublishedDate(date time.Time) {
+	if date.IsZero() {
+		f.Published = nil
+		return
+	}
+	f.Published = &Published{Value: date}
+}
+
+// AddCategory adds a <category> with the given term to the Feed.
+func (f *Feed) AddCategory(category string) {
+	f.Categories = append(f.Categories, NewCategory(category))
+}
+
+// AddItem appends the given item, which must be an *Entry, to the Feed.
+func (f *Feed) AddItem(item types.ItemSource) error {
+	entry, ok := item.(*Entry)
+	if !ok {
+		return fmt.Errorf("%w: cannot add %T to an Atom feed", types.ErrIncompatibleItem, item)
+	}
+	f.Entries = append(f.Entries, *entry)
+	return nil
+}
+
 // GetUpdatedDate returns the <updated> of the Feed.
 func (f *Feed) GetUpdatedDate() *time.Time {
 	if f.Updated.Value.IsZero() {
@@ -212,15 +286,27 @@ func (f *Feed) GetUpdateInterval() time.Duration {
 	return DefaultFeedUpdateInterval
 }
 
-// GetItems returns a slice of Entry values for the Feed.
+// GetItems returns a slice of Entry values for the Feed. The values point to the entries of the Feed, so any changes
+// made to them are reflected in the Feed.
 func (f *Feed) GetItems() []types.ItemSource {
 	items := make([]types.ItemSource, 0, len(f.Entries))
-	for item := range slices.Values(f.Entries) {
-		items = append(items, &item)
+	for idx := range f.Entries {
+		items = append(items, &f.Entries[idx])
 	}
 	return items
 }
 
+// ItemsSeq returns an iterator over the Entry values of the Feed. Unlike GetItems, it does not allocate a slice.
+func (f *Feed) ItemsSeq() iter.Seq[types.ItemSource] {
+	return func(yield func(types.ItemSource) bool) {
+		for idx := range f.Entries {
+			if !yield(&f.Entries[idx]) {
+				return
+			}
+		}
+	}
+}
+
 // Validate applies custom validation to an feed.
 func (f *Feed) Validate() error {
 	// Check for all entries having authors.
@@ -269,6 +355,9 @@ func (f Feed) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + ns.Prefix}, Value: ns.URI})
 	}
 
+	// Declarations kept as attributes when decoding are written from the fields above, so are not written again.
+	f.Attributes = slices.DeleteFunc(slices.Clone(f.Attributes), isNamespaceDecl)
+
 	type feedAlias Feed // sheds Feed's MarshalXML method, breaking recursion
 	if err := enc.EncodeElement(feedAlias(f), start); err != nil {
 		return fmt.Errorf("feed: marshal: %w", err)
@@ -276,6 +365,12 @@ func (f Feed) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 	return nil
 }
 
+// isNamespaceDecl reports whether the given attribute declares a namespace.
+func isNamespaceDecl(attr xml.Attr) bool {
+	return attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") ||
+		strings.HasPrefix(attr.Name.Local, "xmlns:")
+}
+
 func (f *Feed) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
 	var defaultNS string
 	var namespaces []extensions.Namespace
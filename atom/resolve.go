@@ -0,0 +1,76 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package atom
+
+import (
+	"net/url"
+)
+
+// ResolveURLs resolves any relative <link> href, <logo> and <icon> values of the Feed and its entries (including their
+// <source> elements) against the given base URL, which is usually the URL the Feed was fetched from. Any xml:base
+// attributes of the Feed, its entries and the elements themselves are applied on top of the base URL, as described by
+// RFC 4287. Values that are already absolute are left as is. If the base URL cannot be parsed, nothing is changed.
+func (f *Feed) ResolveURLs(base string) {
+	baseURL, err := url.Parse(base)
+	if err != nil || base == "" {
+		return
+	}
+	baseURL = withBase(baseURL, f.Base)
+	resolveLinks(baseURL, f.Links)
+	resolveImages(baseURL, f.Logo, f.Icon)
+	for idx := range f.Entries {
+		entry := &f.Entries[idx]
+		entryURL := withBase(baseURL, entry.Base)
+		resolveLinks(entryURL, entry.Links)
+		if entry.Source != nil {
+			sourceURL := withBase(entryURL, entry.Source.Base)
+			resolveLinks(sourceURL, entry.Source.Links)
+			resolveImages(sourceURL, entry.Source.Logo, entry.Source.Icon)
+		}
+	}
+}
+
+// resolveImages resolves the values of the given <logo> and <icon> (if any) against the given base URL, and any
+// xml:base of the element.
+func resolveImages(base *url.URL, logo *Logo, icon *Icon) {
+	if logo != nil {
+		logo.Value = resolveRef(withBase(base, logo.Base), logo.Value)
+	}
+	if icon != nil {
+		icon.Value = resolveRef(withBase(base, icon.Base), icon.Value)
+	}
+}
+
+// resolveLinks resolves the href of each of the given links against the given base URL, and any xml:base of the link.
+func resolveLinks(base *url.URL, links Links) {
+	for idx := range links {
+		links[idx].Href = resolveRef(withBase(base, links[idx].Base), links[idx].Href)
+	}
+}
+
+// withBase returns the given base URL with the given xml:base value (if any) applied to it. If the xml:base value
+// cannot be parsed, the base URL is returned as is.
+func withBase(base *url.URL, xmlBase *string) *url.URL {
+	if xmlBase == nil || *xmlBase == "" {
+		return base
+	}
+	ref, err := url.Parse(*xmlBase)
+	if err != nil {
+		return base
+	}
+	return base.ResolveReference(ref)
+}
+
+// resolveRef resolves the given reference against the given base URL. Empty references, and those that cannot be
+// parsed, are returned as is.
+func resolveRef(base *url.URL, ref string) string {
+	if ref == "" {
+		return ref
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil || refURL.IsAbs() {
+		return ref
+	}
+	return base.ResolveReference(refURL).String()
+}
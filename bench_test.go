@@ -0,0 +1,107 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeSample decodes the given corpus sample into a Feed.
+func decodeSample(sample corpus.Sample, options ...feeds.Option) (*feeds.Feed, error) {
+	data := bytes.NewReader(sample.Data)
+	switch sample.Format {
+	case types.SourceTypeAtom:
+		return feeds.NewDecoder[*atom.Feed](data, options...)
+	case types.SourceTypeRDF:
+		return feeds.NewDecoder[*rdf.RDF](data, options...)
+	case types.SourceTypeJSONFeed:
+		return feeds.NewDecoder[*jsonfeed.Feed](data, options...)
+	default:
+		return feeds.NewDecoder[*rss.RSS](data, options...)
+	}
+}
+
+func BenchmarkCorpusDecode(b *testing.B) {
+	for sample := range slices.Values(corpus.Samples()) {
+		b.Run(sample.Name, func(b *testing.B) {
+			b.SetBytes(int64(len(sample.Data)))
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := decodeSample(sample); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCorpusValidate(b *testing.B) {
+	for sample := range slices.Values(corpus.Samples()) {
+		b.Run(sample.Name, func(b *testing.B) {
+			feed, err := decodeSample(sample)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				_ = feed.FeedSource.Validate()
+			}
+		})
+	}
+}
+
+func BenchmarkFeedUnmarshalBinary(b *testing.B) {
+	feed, err := feeds.NewDecoder[*rss.RSS](bytes.NewReader(corpus.RSS(500)))
+	require.NoError(b, err)
+	data, err := feed.MarshalBinary()
+	require.NoError(b, err)
+	b.ReportAllocs()
+	for b.Loop() {
+		var got feeds.Feed
+		if err := got.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFeedDecodeXML decodes the same feed as BenchmarkFeedUnmarshalBinary from XML, for comparison.
+func BenchmarkFeedDecodeXML(b *testing.B) {
+	data := corpus.RSS(500)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := feeds.NewDecoder[*rss.RSS](bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCorpusItems(b *testing.B) {
+	for sample := range slices.Values(corpus.Samples()) {
+		b.Run(sample.Name, func(b *testing.B) {
+			feed, err := decodeSample(sample)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				for item := range feed.ItemsSeq() {
+					_ = item.GetPublishedDate()
+					_ = item.GetDescription()
+					_ = item.GetContent()
+				}
+			}
+		})
+	}
+}
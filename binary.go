@@ -0,0 +1,79 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func init() {
+	// Register the types that can appear in the additional properties of a JSONFeed.
+	gob.Register(map[string]any{})
+	gob.Register([]any{})
+}
+
+// binaryFeed is the binary representation of a Feed. Each source format has its own field, so that the source can be
+// restored to its original type without registering the FeedSource implementations.
+type binaryFeed struct {
+	SourceType types.SourceType
+	Atom       *atom.Feed
+	RSS        *rss.RSS
+	RDF        *rdf.RDF
+	JSONFeed   *jsonfeed.Feed
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The Feed is encoded with encoding/gob, which is significantly
+// faster to decode than the original feed data for all but the smallest feeds, making it suitable for caching parsed
+// feeds. Any options the Feed was created with are not encoded.
+func (f *Feed) MarshalBinary() ([]byte, error) {
+	data := binaryFeed{SourceType: f.SourceType}
+	switch source := f.FeedSource.(type) {
+	case *atom.Feed:
+		data.Atom = source
+	case *rss.RSS:
+		data.RSS = source
+	case *rdf.RDF:
+		data.RDF = source
+	case *jsonfeed.Feed:
+		data.JSONFeed = source
+	default:
+		return nil, fmt.Errorf("marshal feed: unsupported source type %T", f.FeedSource)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("marshal feed: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It restores a Feed encoded with MarshalBinary.
+func (f *Feed) UnmarshalBinary(v []byte) error {
+	var data binaryFeed
+	if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&data); err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshal, err)
+	}
+	switch {
+	case data.Atom != nil:
+		f.FeedSource = data.Atom
+	case data.RSS != nil:
+		f.FeedSource = data.RSS
+	case data.RDF != nil:
+		f.FeedSource = data.RDF
+	case data.JSONFeed != nil:
+		f.FeedSource = data.JSONFeed
+	default:
+		return fmt.Errorf("%w: unknown data type", ErrUnmarshal)
+	}
+	f.SourceType = data.SourceType
+	f.validation = &validationResult{}
+	return nil
+}
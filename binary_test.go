@@ -0,0 +1,38 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedBinary(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+
+			data, err := feed.MarshalBinary()
+			require.NoError(t, err)
+
+			var got Feed
+			require.NoError(t, got.UnmarshalBinary(data))
+			assert.Equal(t, feed.SourceType, got.SourceType)
+			assert.IsType(t, feed.FeedSource, got.FeedSource)
+			assert.Equal(t, feed.GetTitle(), got.GetTitle())
+			require.Len(t, got.GetItems(), len(feed.GetItems()))
+			for idx, item := range got.GetItems() {
+				assert.Equal(t, feed.GetItems()[idx].GetTitle(), item.GetTitle())
+				assert.Equal(t, feed.GetItems()[idx].GetLink(), item.GetLink())
+			}
+		})
+	}
+}
+
+func TestFeedUnmarshalBinaryInvalid(t *testing.T) {
+	var feed Feed
+	require.ErrorIs(t, feed.UnmarshalBinary([]byte("not a feed")), ErrUnmarshal)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/net/html/charset"
+)
+
+var (
+	// utf16BEBOM is the byte order mark of big-endian UTF-16 documents.
+	utf16BEBOM = []byte("\xfe\xff")
+	// utf16LEBOM is the byte order mark of little-endian UTF-16 documents.
+	utf16LEBOM = []byte("\xff\xfe")
+)
+
+// decodeBOM returns a reader of the document in the given io.Reader as UTF-8 without a byte order mark. A UTF-8 byte
+// order mark is removed and a UTF-16 document, which must start with one, is converted to UTF-8. As the byte order
+// mark takes precedence over the encoding declaration of an XML document, any declaration in a document that starts
+// with one is replaced with UTF-8, so that the document is not decoded again as the declared encoding. Documents
+// without a byte order mark are returned as is.
+func decodeBOM(rd io.Reader) (io.Reader, error) {
+	data := bufio.NewReaderSize(rd, sniffSize)
+	mark, err := data.Peek(len(utf8BOM))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("peek at byte order mark: %w", err)
+	}
+	var (
+		decoded io.Reader
+		label   string
+	)
+	switch {
+	case bytes.HasPrefix(mark, utf8BOM):
+		decoded = data
+	case bytes.HasPrefix(mark, utf16BEBOM):
+		label = "utf-16be"
+	case bytes.HasPrefix(mark, utf16LEBOM):
+		label = "utf-16le"
+	default:
+		return data, nil
+	}
+	if label != "" {
+		// The byte order mark is removed first, as the decoder would otherwise keep it.
+		_, _ = data.Discard(len(utf16BEBOM))
+		encoding, _ := charset.Lookup(label)
+		decoded = encoding.NewDecoder().Reader(data)
+	} else {
+		_, _ = data.Discard(len(utf8BOM))
+	}
+	return replaceEncodingDecl(decoded)
+}
+
+// replaceEncodingDecl returns a reader of the XML document in the given io.Reader with the encoding in its encoding
+// declaration, if any, replaced with UTF-8.
+func replaceEncodingDecl(rd io.Reader) (io.Reader, error) {
+	data := bufio.NewReaderSize(rd, sniffSize)
+	head, err := data.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("peek at encoding declaration: %w", err)
+	}
+	match := xmlEncodingDecl.FindSubmatchIndex(head)
+	if match == nil {
+		return data, nil
+	}
+	declaration := make([]byte, 0, match[1]+len("UTF-8"))
+	declaration = append(declaration, head[:match[2]]...)
+	declaration = append(declaration, "UTF-8"...)
+	declaration = append(declaration, head[match[3]:match[1]]...)
+	_, _ = data.Discard(match[1])
+	return io.MultiReader(bytes.NewReader(declaration), data), nil
+}
+
+// hasUTF16BOM reports whether the given data starts with a UTF-16 byte order mark.
+func hasUTF16BOM(data []byte) bool {
+	return bytes.HasPrefix(data, utf16BEBOM) || bytes.HasPrefix(data, utf16LEBOM)
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// encodeUTF16 encodes the given document as UTF-16 in the given byte order, after the given byte order mark.
+func encodeUTF16(order binary.AppendByteOrder, bom []byte, document string) []byte {
+	data := bytes.Clone(bom)
+	for unit := range slices.Values(utf16.Encode([]rune(document))) {
+		data = order.AppendUint16(data, unit)
+	}
+	return data
+}
+
+func TestNewFeedFromBytesBOM(t *testing.T) {
+	rssDocument := `<rss version="2.0"><channel><title>Café</title></channel></rss>`
+	jsonDocument := `{"version":"https://jsonfeed.org/version/1.1","title":"Café"}`
+
+	tests := map[string]struct {
+		data []byte
+		want types.SourceType
+	}{
+		"utf-8 xml": {
+			data: append(bytes.Clone(utf8BOM), rssDocument...),
+			want: types.SourceTypeRSS,
+		},
+		"utf-8 xml with wrong declaration": {
+			data: append(bytes.Clone(utf8BOM), `<?xml version="1.0" encoding="ISO-8859-1"?>`+rssDocument...),
+			want: types.SourceTypeRSS,
+		},
+		"utf-16be xml": {
+			data: encodeUTF16(binary.BigEndian, utf16BEBOM, `<?xml version="1.0" encoding="UTF-16"?>`+rssDocument),
+			want: types.SourceTypeRSS,
+		},
+		"utf-16le xml": {
+			data: encodeUTF16(binary.LittleEndian, utf16LEBOM, rssDocument),
+			want: types.SourceTypeRSS,
+		},
+		"utf-8 json": {
+			data: append(bytes.Clone(utf8BOM), jsonDocument...),
+			want: types.SourceTypeJSONFeed,
+		},
+		"utf-16le json": {
+			data: encodeUTF16(binary.LittleEndian, utf16LEBOM, jsonDocument),
+			want: types.SourceTypeJSONFeed,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectFormat(tt.data))
+			feed, err := NewFeedFromBytes(tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, feed.SourceType)
+			assert.Equal(t, "Café", feed.GetTitle())
+		})
+	}
+
+	// The generic decoders also handle byte order marks.
+	data := tests["utf-16be xml"].data
+	source, err := Decode[*rss.RSS]("", bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "Café", source.GetTitle())
+	feed, err := NewDecoder[*rss.RSS](bytes.NewReader(data), WithRawSource())
+	require.NoError(t, err)
+	assert.Equal(t, "Café", feed.GetTitle())
+	assert.NotContains(t, string(feed.Raw()), "UTF-16")
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Clone returns a deep copy of the Feed. The source of the copy (i.e., the rss.RSS, atom.Feed, etc.) shares no memory
+// with the source of the Feed, so either can be modified, such as to convert or enrich it, without affecting the other.
+// The copy has the same options as the Feed. Any cached validation result is not copied.
+func (f *Feed) Clone() *Feed {
+	return &Feed{
+		FeedSource:  deepCopy(f.FeedSource),
+		SourceType:  f.SourceType,
+		config:      f.config,
+		validation:  &validationResult{},
+		raw:         f.raw,
+		contentType: f.contentType,
+		fetchURLs:   f.fetchURLs,
+		repairs:     f.repairs,
+		stats:       f.stats,
+	}
+}
+
+// Clone returns a deep copy of the Item. The source of the copy (i.e., the rss.Item, atom.Entry, etc.) shares no
+// memory with the source of the Item, so either can be modified without affecting the other.
+func (i *Item) Clone() *Item {
+	clone := *i
+	clone.ItemSource = deepCopy(i.ItemSource)
+	clone.feedLanguage = deepCopy(i.feedLanguage)
+	clone.feedAuthors = slices.Clone(i.feedAuthors)
+	clone.feedRights = deepCopy(i.feedRights)
+	return &clone
+}
+
+// deepCopy returns a deep copy of the given value. Pointers, slices, maps and interfaces are followed and their values
+// copied. Unexported struct fields cannot be set, so they are copied as is; the source types of this package only
+// store their data in exported fields.
+func deepCopy[T any](value T) T {
+	copied, ok := deepCopyValue(reflect.ValueOf(&value).Elem()).Interface().(T)
+	if !ok {
+		return value
+	}
+	return copied
+}
+
+// deepCopyValue returns a deep copy of the given reflected value, see deepCopy.
+func deepCopyValue(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(deepCopyValue(value.Elem()))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(deepCopyValue(value.Elem()))
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for idx := range value.Len() {
+			copied.Index(idx).Set(deepCopyValue(value.Index(idx)))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for key, element := range value.Seq2() {
+			copied.SetMapIndex(deepCopyValue(key), deepCopyValue(element))
+		}
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for idx := range value.NumField() {
+			if field := copied.Field(idx); field.CanSet() {
+				field.Set(deepCopyValue(value.Field(idx)))
+			}
+		}
+		return copied
+	default:
+		return value
+	}
+}
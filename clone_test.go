@@ -0,0 +1,55 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedClone(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+			clone := feed.Clone()
+			require.NotSame(t, feed.FeedSource, clone.FeedSource)
+			assert.Equal(t, feed.FeedSource, clone.FeedSource)
+			assert.Equal(t, feed.SourceType, clone.SourceType)
+
+			clone.SetTitle("Cloned Feed")
+			clone.AddCategory("cloned")
+			clone.FeedSource.GetItems()[0].SetTitle("Cloned Item")
+			require.NoError(t, clone.AddItem(clone.FeedSource.GetItems()[1]))
+
+			assert.Equal(t, "Example Feed", feed.GetTitle())
+			assert.NotContains(t, feed.GetCategories(), "cloned")
+			items := feed.GetItems()
+			require.Len(t, items, 3)
+			assert.Equal(t, "First", items[0].GetTitle())
+			assert.Len(t, clone.GetItems(), 4)
+		})
+	}
+}
+
+func TestItemClone(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format, WithFeedInheritance())
+			item := feed.GetItems()[0]
+			clone := item.Clone()
+			assert.Equal(t, item.FeedTitle, clone.FeedTitle)
+			assert.Equal(t, item.GetLanguage(), clone.GetLanguage())
+
+			clone.SetTitle("Cloned Item")
+			clone.AddCategory("cloned")
+
+			assert.Equal(t, "First", item.GetTitle())
+			assert.NotContains(t, item.GetCategories(), "cloned")
+			assert.Equal(t, "First", feed.GetItems()[0].GetTitle())
+			assert.Equal(t, "Cloned Item", clone.GetTitle())
+		})
+	}
+}
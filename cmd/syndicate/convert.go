@@ -0,0 +1,300 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// jsonFeedVersion is the version of JSONFeed written by the convert command.
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// ConvertCMD command will convert the feed from the given URL or file into another format and print it. Only the
+// fields of the unified model (title, links, dates, authors, categories etc.) are carried over; format-specific
+// extensions are dropped.
+type ConvertCMD struct {
+	Source string `arg:"" help:"The URL or file of the feed"`
+	To     string `       help:"The format to convert to" enum:"atom,rss,jsonfeed" required:""`
+}
+
+func (c *ConvertCMD) Run() error {
+	ctx, cancelFunc := newContext()
+	defer cancelFunc()
+
+	feed, err := loadFeed(ctx, c.Source)
+	if err != nil {
+		return err
+	}
+
+	data, err := convertFeed(feed, c.To)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
+
+// convertFeed converts the given feed into the given format and encodes it.
+func convertFeed(feed *feeds.Feed, format string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch format {
+	case "atom":
+		data, err = feeds.Encode(toAtom(feed))
+	case "rss":
+		data, err = feeds.Encode(toRSS(feed))
+	case "jsonfeed":
+		return encodeJSON(toJSONFeed(feed))
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode feed: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// toRSS converts the given feed into an RSS feed.
+func toRSS(feed *feeds.Feed) *rss.RSS {
+	var options []rss.RSSOption
+	if lang := feed.GetLanguage(); lang != nil {
+		options = append(options, rss.WithChannelLanguage(*lang))
+	}
+	if rights := feed.GetRights(); rights != nil {
+		options = append(options, rss.WithCopyright(*rights))
+	}
+	if published := feed.GetPublishedDate(); published != nil {
+		options = append(options, rss.WithPublishedDate(*published))
+	}
+	if image := feed.GetImage(); image != nil {
+		options = append(options, rss.WithChannelImage(&rss.Image{
+			URL:   image.URL,
+			Title: firstNonEmpty(image.Title, feed.GetTitle()),
+			Link:  feed.GetLink(),
+		}))
+	}
+	target := rss.NewRSS(feed.GetTitle(), feed.GetDescription(), feed.GetLink(), options...)
+	for category := range slices.Values(feed.GetCategories()) {
+		target.Channel.Categories = append(target.Channel.Categories, rss.Category{Value: category})
+	}
+
+	for item := range feed.ItemsSeq() {
+		options := []rss.ItemOption{
+			rss.WithItemTitle(item.GetTitle()),
+			rss.WithItemLink(item.GetLink()),
+		}
+		if id := item.GetID(); id != "" {
+			options = append(options, rss.WithItemGUID(rss.NewGUID(id, id == item.GetLink())))
+		}
+		if desc := item.GetDescription(); desc != "" {
+			options = append(options, rss.WithItemDescription(desc, true))
+		}
+		if content := item.GetContent(); content != nil {
+			options = append(options, rss.WithItemContent(*content, true))
+		}
+		if image := item.GetImage(); image != nil {
+			options = append(options, rss.WithItemImage(image))
+		}
+		targetItem := rss.NewItem(options...)
+		// Don't default the date of undated items to now.
+		targetItem.PubDate = nil
+		if published := item.GetPublishedDate(); published != nil {
+			targetItem.PubDate = rss.NewTimestamp(*published)
+		}
+		if authors := item.GetAuthors(); len(authors) > 0 {
+			targetItem.Author = new(authors[0])
+		}
+		for category := range slices.Values(item.GetCategories()) {
+			targetItem.Categories = append(targetItem.Categories, rss.Category{Value: category})
+		}
+		target.Channel.Items = append(target.Channel.Items, *targetItem)
+	}
+
+	return target
+}
+
+// toAtom converts the given feed into an Atom feed. As Atom requires an ID and updated date, the link of the feed is
+// used as its ID if it has no other, and the current time as its updated date if it has no other.
+func toAtom(feed *feeds.Feed) *atom.Feed {
+	target := &atom.Feed{
+		ID:      atom.ID{Value: firstNonEmpty(feed.GetLink(), feed.GetSourceURL())},
+		Title:   atom.Title{Value: feed.GetTitle()},
+		Updated: atom.Updated{Value: dateOrNow(feed.GetUpdatedDate(), feed.GetPublishedDate())},
+		Authors: toAtomAuthors(feed.GetAuthors()),
+		Links:   toAtomLinks(feed.GetLink(), feed.GetSourceURL()),
+		Lang:    feed.GetLanguage(),
+	}
+	if desc := feed.GetDescription(); desc != "" {
+		target.Subtitle = &atom.Subtitle{Value: desc}
+	}
+	if rights := feed.GetRights(); rights != nil {
+		target.Rights = &atom.Rights{Value: *rights}
+	}
+	if image := feed.GetImage(); image != nil {
+		target.Icon = &atom.Icon{Value: image.URL}
+	}
+	target.Categories = toAtomCategories(feed.GetCategories())
+
+	for item := range feed.ItemsSeq() {
+		entry := atom.Entry{
+			ID:         atom.ID{Value: firstNonEmpty(item.GetID(), item.GetLink())},
+			Title:      atom.Title{Value: item.GetTitle()},
+			Updated:    atom.Updated{Value: dateOrNow(item.GetUpdatedDate(), item.GetPublishedDate())},
+			Authors:    toAtomAuthors(item.GetAuthors()),
+			Links:      toAtomLinks(item.GetLink(), ""),
+			Categories: toAtomCategories(item.GetCategories()),
+		}
+		if published := item.GetPublishedDate(); published != nil {
+			entry.Published = &atom.Published{Value: *published}
+		}
+		if desc := item.GetDescription(); desc != "" {
+			entry.Summary = &atom.Summary{Type: new(atom.TypeHtml), Value: desc}
+		}
+		if content := item.GetContent(); content != nil {
+			entry.Content = &atom.Content{Type: new(atom.TypeHtml), Text: content}
+		}
+		target.Entries = append(target.Entries, entry)
+	}
+
+	return target
+}
+
+// toAtomAuthors converts the given author names into Atom person constructs.
+func toAtomAuthors(authors []string) atom.Authors {
+	var persons atom.Authors
+	for author := range slices.Values(authors) {
+		persons = append(persons, atom.PersonConstruct{Name: author})
+	}
+	return persons
+}
+
+// toAtomLinks converts the given (alternate) link and self link into Atom links, omitting either if it is empty.
+func toAtomLinks(link, self string) atom.Links {
+	var links atom.Links
+	if link != "" {
+		links = append(links, atom.Link{Href: link, Rel: atom.LinkRelAlternate})
+	}
+	if self != "" {
+		links = append(links, atom.Link{Href: self, Rel: atom.LinkRelSelf})
+	}
+	return links
+}
+
+// toAtomCategories converts the given category names into Atom categories.
+func toAtomCategories(categories []string) atom.Categories {
+	var targets atom.Categories
+	for category := range slices.Values(categories) {
+		targets = append(targets, atom.Category{Term: xml.Attr{Name: xml.Name{Local: "term"}, Value: category}})
+	}
+	return targets
+}
+
+// toJSONFeed converts the given feed into a JSONFeed.
+func toJSONFeed(feed *feeds.Feed) *jsonfeed.Feed {
+	target := &jsonfeed.Feed{
+		Version:     jsonFeedVersion,
+		Title:       feed.GetTitle(),
+		HomePageURL: optional(feed.GetLink()),
+		FeedURL:     optional(feed.GetSourceURL()),
+		Description: optional(feed.GetDescription()),
+		Language:    feed.GetLanguage(),
+		Authors:     toJSONFeedAuthors(feed.GetAuthors()),
+		Items:       []jsonfeed.Item{},
+	}
+	if image := feed.GetImage(); image != nil {
+		target.Icon = new(image.URL)
+	}
+
+	for item := range feed.ItemsSeq() {
+		targetItem := jsonfeed.Item{
+			ID:            firstNonEmpty(item.GetID(), item.GetLink()),
+			URL:           optional(item.GetLink()),
+			Title:         optional(item.GetTitle()),
+			Summary:       optional(item.GetDescription()),
+			ContentHTML:   item.GetContent(),
+			DatePublished: formatDate(item.GetPublishedDate()),
+			DateModified:  formatDate(item.GetUpdatedDate()),
+			Authors:       toJSONFeedAuthors(item.GetAuthors()),
+			Tags:          item.GetCategories(),
+			Language:      item.GetLanguage(),
+		}
+		if targetItem.ContentHTML == nil {
+			// JSONFeed items must have content, so fall back to the description.
+			targetItem.ContentHTML = new(item.GetDescription())
+		}
+		if image := item.GetImage(); image != nil {
+			targetItem.Image = new(image.URL)
+		}
+		target.Items = append(target.Items, targetItem)
+	}
+
+	return target
+}
+
+// toJSONFeedAuthors converts the given author names into JSONFeed authors.
+func toJSONFeedAuthors(authors []string) []jsonfeed.Author {
+	var targets []jsonfeed.Author
+	for author := range slices.Values(authors) {
+		targets = append(targets, jsonfeed.Author{Name: new(author)})
+	}
+	return targets
+}
+
+// encodeJSON encodes the given value as indented JSON.
+func encodeJSON(v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode JSON: %w", err)
+	}
+	return data, nil
+}
+
+// dateOrNow returns the first of the given dates that is set, or the current time if none are.
+func dateOrNow(dates ...*time.Time) time.Time {
+	for date := range slices.Values(dates) {
+		if date != nil {
+			return *date
+		}
+	}
+	return time.Now().UTC()
+}
+
+// formatDate formats the given date as RFC 3339, or returns nil if there is no date.
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	return new(date.Format(time.RFC3339))
+}
+
+// optional returns a pointer to the given value, or nil if it is empty.
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// firstNonEmpty returns the first of the given values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for value := range slices.Values(values) {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
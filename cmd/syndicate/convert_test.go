@@ -0,0 +1,61 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestConvertFeed(t *testing.T) {
+	sources := map[string][]byte{
+		"rss":      corpus.RSS(3),
+		"atom":     corpus.Atom(3),
+		"jsonfeed": corpus.JSONFeed(3),
+	}
+	targets := map[string]types.SourceType{
+		"rss":      types.SourceTypeRSS,
+		"atom":     types.SourceTypeAtom,
+		"jsonfeed": types.SourceTypeJSONFeed,
+	}
+	for from, data := range sources {
+		for to, sourceType := range targets {
+			t.Run(from+" to "+to, func(t *testing.T) {
+				feed, err := feeds.NewFeedFromBytes(data)
+				require.NoError(t, err)
+
+				converted, err := convertFeed(feed, to)
+				require.NoError(t, err)
+
+				got, err := feeds.NewFeedFromBytes(converted)
+				require.NoError(t, err)
+				assert.Equal(t, sourceType, got.SourceType)
+				assert.Equal(t, feed.GetTitle(), got.GetTitle())
+				require.Len(t, got.GetItems(), len(feed.GetItems()))
+				for idx, item := range got.GetItems() {
+					want := feed.GetItems()[idx]
+					assert.Equal(t, want.GetTitle(), item.GetTitle())
+					assert.Equal(t, want.GetLink(), item.GetLink())
+					if date := want.GetPublishedDate(); date != nil {
+						require.NotNil(t, item.GetPublishedDate())
+						assert.True(t, date.Equal(*item.GetPublishedDate()))
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestConvertFeedUnsupported(t *testing.T) {
+	feed, err := feeds.NewFeedFromBytes(corpus.RSS(1))
+	require.NoError(t, err)
+	_, err = convertFeed(feed, "opml")
+	require.Error(t, err)
+}
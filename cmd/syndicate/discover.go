@@ -0,0 +1,140 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// feedMediaTypes maps the media types used to advertise feeds in <link rel="alternate"> elements to their source type.
+var feedMediaTypes = map[string]types.SourceType{
+	"application/rss+xml":   types.SourceTypeRSS,
+	"application/atom+xml":  types.SourceTypeAtom,
+	"application/rdf+xml":   types.SourceTypeRDF,
+	"application/feed+json": types.SourceTypeJSONFeed,
+	"application/json":      types.SourceTypeJSONFeed,
+}
+
+// DiscoverCMD command will discover the feeds advertised by the web page at the given URL, via its <link
+// rel="alternate"> elements, and print them as JSON. If the URL is itself a feed, it is printed instead.
+type DiscoverCMD struct {
+	URL string `arg:"" help:"The URL of the web page"`
+}
+
+// discoveredFeed is a feed found by the discover command.
+type discoveredFeed struct {
+	URL   string           `json:"url"`
+	Type  types.SourceType `json:"type"`
+	Title string           `json:"title,omitempty"`
+}
+
+func (c *DiscoverCMD) Run() error {
+	ctx, cancelFunc := newContext()
+	defer cancelFunc()
+
+	pageURL, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("parse URL: %w", err)
+	}
+	resp, err := LoadHTTPClient().R().
+		SetContext(ctx).
+		SetResponseBodyLimit(maxBodySize).
+		Get(pageURL.String())
+	if err != nil {
+		return fmt.Errorf("fetch page: %w", err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("fetch page: %s", resp.Status())
+	}
+	// Use the final URL after any redirects to resolve relative links.
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		pageURL = resp.RawResponse.Request.URL
+	}
+
+	found, err := discoverFeeds(pageURL, resp.Body())
+	if err != nil {
+		return err
+	}
+	data, err := encodeJSON(found)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
+
+// discoverFeeds finds the feeds in the given data fetched from the given URL. If the data is a feed, it is the only feed
+// found. If it is HTML, the feeds advertised by its <link rel="alternate"> elements are found, with relative links
+// resolved against the URL. Links that cannot be fetched over HTTP(S) are ignored.
+func discoverFeeds(pageURL *url.URL, data []byte) ([]discoveredFeed, error) {
+	sourceType, err := feeds.DetectSourceType(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("detect content: %w", err)
+	}
+	switch sourceType {
+	case types.SourceTypeHTML:
+		return findFeedLinks(pageURL, bytes.NewReader(data))
+	case types.SourceTypeUnknown:
+		return []discoveredFeed{}, nil
+	default:
+		found := discoveredFeed{URL: pageURL.String(), Type: sourceType}
+		if feed, err := feeds.NewFeedFromBytes(data); err == nil {
+			found.Title = feed.GetTitle()
+		}
+		return []discoveredFeed{found}, nil
+	}
+}
+
+// findFeedLinks finds the feeds advertised by the <link rel="alternate"> elements of the given HTML document.
+func findFeedLinks(pageURL *url.URL, r io.Reader) ([]discoveredFeed, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse page: %w", err)
+	}
+
+	found := []discoveredFeed{}
+	seen := make(map[string]bool)
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode || node.DataAtom != atom.Link {
+			continue
+		}
+		var rel, mediaType, href, title string
+		for attr := range slices.Values(node.Attr) {
+			switch strings.ToLower(attr.Key) {
+			case "rel":
+				rel = strings.ToLower(attr.Val)
+			case "type":
+				mediaType = strings.ToLower(strings.TrimSpace(attr.Val))
+			case "href":
+				href = strings.TrimSpace(attr.Val)
+			case "title":
+				title = attr.Val
+			}
+		}
+		sourceType, ok := feedMediaTypes[mediaType]
+		if !ok || href == "" || !slices.Contains(strings.Fields(rel), "alternate") {
+			continue
+		}
+		link, err := pageURL.Parse(href)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") || seen[link.String()] {
+			continue
+		}
+		seen[link.String()] = true
+		found = append(found, discoveredFeed{URL: link.String(), Type: sourceType, Title: title})
+	}
+	return found, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestDiscoverFeeds(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com/blog/")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data []byte
+		want []discoveredFeed
+	}{
+		{
+			name: "html",
+			data: []byte(`<!DOCTYPE html>
+<html><head>
+<link rel="stylesheet" href="/style.css">
+<link rel="alternate" type="application/rss+xml" title="RSS" href="feed.xml">
+<link rel="Alternate" type="Application/Atom+XML" href="https://example.com/atom.xml">
+<link rel="alternate" type="application/feed+json" href="/feed.json">
+<link rel="alternate" type="application/rss+xml" href="feed.xml">
+<link rel="alternate" type="application/rss+xml" href="ftp://example.com/feed.xml">
+<link rel="alternate" hreflang="fr" href="/fr/">
+</head><body></body></html>`),
+			want: []discoveredFeed{
+				{URL: "https://example.com/blog/feed.xml", Type: types.SourceTypeRSS, Title: "RSS"},
+				{URL: "https://example.com/atom.xml", Type: types.SourceTypeAtom},
+				{URL: "https://example.com/feed.json", Type: types.SourceTypeJSONFeed},
+			},
+		},
+		{
+			name: "no feeds",
+			data: []byte(`<!DOCTYPE html><html><head><title>Blog</title></head><body></body></html>`),
+			want: []discoveredFeed{},
+		},
+		{
+			name: "feed",
+			data: corpus.RSS(1),
+			want: []discoveredFeed{
+				{URL: "https://example.com/blog/", Type: types.SourceTypeRSS, Title: "Example Feed"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := discoverFeeds(pageURL, tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
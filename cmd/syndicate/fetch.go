@@ -0,0 +1,137 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/proto"
+)
+
+// FetchCMD command will fetch a feed from the given URL or file and display it. By default, the feed is printed in its
+// normalized JSON form, i.e., the same fields regardless of the format of the feed.
+type FetchCMD struct {
+	Source string `arg:"" help:"The URL or file of the feed"`
+	Text   bool   `       help:"Print a human-readable summary instead of JSON"`
+}
+
+func (c *FetchCMD) Run() error {
+	ctx, cancelFunc := newContext()
+	defer cancelFunc()
+
+	feed, err := loadFeed(ctx, c.Source)
+	if err != nil {
+		return err
+	}
+
+	if c.Text {
+		showFeedDetails(feed)
+		return nil
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(proto.ToProto(feed))
+	if err != nil {
+		return fmt.Errorf("marshal feed: %w", err)
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
+
+func showFeedDetails(feed *feeds.Feed) {
+	var str strings.Builder
+
+	str.WriteString("Feed: ")
+	str.WriteString(feed.GetTitle())
+	str.WriteRune('\n')
+	str.WriteString("Link: ")
+	str.WriteString(feed.GetLink())
+	str.WriteRune('\n')
+	str.WriteString("Type: ")
+	str.WriteString(string(feed.SourceType))
+	str.WriteRune('\n')
+	if feed.GetDescription() != "" {
+		str.WriteString("Description:")
+		str.WriteRune('\n')
+		str.WriteString(feed.GetDescription())
+		str.WriteRune('\n')
+	}
+	if feed.GetPublishedDate() != nil {
+		str.WriteString("Created: ")
+		str.WriteString(feed.GetPublishedDate().Format(time.DateTime))
+		str.WriteRune('\n')
+	}
+	if feed.GetUpdatedDate() != nil {
+		str.WriteString("Updated: ")
+		str.WriteString(feed.GetUpdatedDate().Format(time.DateTime))
+		str.WriteRune('\n')
+	}
+	if len(feed.GetCategories()) > 0 {
+		str.WriteString("Categories: ")
+		str.WriteString(strings.Join(feed.GetCategories(), ","))
+		str.WriteRune('\n')
+	}
+	if feed.GetImage() != nil {
+		str.WriteString("Image: ")
+		str.WriteString(feed.GetImage().URL)
+	}
+	str.WriteRune('\n')
+	str.WriteRune('\n')
+
+	for item := range slices.Values(feed.GetItems()) {
+		str.WriteString("---")
+		str.WriteRune('\n')
+		if item.GetID() != "" {
+			str.WriteString("Item ID: ")
+			str.WriteString(item.GetID())
+			str.WriteRune('\n')
+		}
+		str.WriteString("Title: ")
+		str.WriteString(item.GetTitle())
+		str.WriteRune('\n')
+		str.WriteString("Link: ")
+		str.WriteString(item.GetLink())
+		str.WriteRune('\n')
+		if len(item.GetAuthors()) > 0 {
+			str.WriteString("Authors: ")
+			str.WriteString(strings.Join(item.GetAuthors(), ","))
+			str.WriteRune('\n')
+		}
+		if item.GetDescription() != "" {
+			str.WriteString("Description:")
+			str.WriteRune('\n')
+			str.WriteString(item.GetDescription())
+			str.WriteRune('\n')
+		}
+		if item.GetPublishedDate() != nil {
+			str.WriteString("Published: ")
+			str.WriteString(item.GetPublishedDate().Format(time.DateTime))
+			str.WriteRune('\n')
+		}
+		if len(item.GetCategories()) > 0 {
+			str.WriteString("Categories: ")
+			str.WriteString(strings.Join(item.GetCategories(), ","))
+			str.WriteRune('\n')
+		}
+		if item.GetImage() != nil {
+			str.WriteString("Image: ")
+			str.WriteString(item.GetImage().URL)
+		}
+		if item.GetContent() != nil {
+			str.WriteString("Content:")
+			str.WriteRune('\n')
+			str.WriteString(*item.GetContent())
+		}
+		str.WriteRune('\n')
+	}
+
+	fmt.Fprintf(os.Stdout, "%s", str.String())
+}
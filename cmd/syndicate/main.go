@@ -0,0 +1,149 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Command syndicate is a command-line tool for fetching, converting, validating and discovering syndication feeds
+// (RSS, Atom, RDF and JSONFeed), and for importing and exporting OPML subscription lists. It doubles as a worked example
+// of the go-syndication API.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/alecthomas/kong"
+	"github.com/go-resty/resty/v2"
+
+	feeds "github.com/immanent-tech/go-syndication"
+)
+
+// maxBodySize is the maximum size of a feed that will be read.
+const maxBodySize = 10 * 1024 * 1024 // 10 MB limit
+
+// Exit codes returned by the commands.
+const (
+	// ExitOK indicates the command succeeded.
+	ExitOK = 0
+	// ExitInvalid indicates the command ran, but the feed was found to be invalid.
+	ExitInvalid = 1
+	// ExitError indicates the command could not be run, such as when the feed could not be fetched or parsed.
+	ExitError = 2
+)
+
+type Globals struct{}
+
+// CLI contains all options and commands.
+type CLI struct {
+	Globals
+
+	Fetch    FetchCMD    `cmd:"" help:"Fetch a feed and print it as normalized JSON"`
+	Convert  ConvertCMD  `cmd:"" help:"Convert a feed to another format"`
+	Validate ValidateCMD `cmd:"" help:"Validate a feed and print a report"`
+	Discover DiscoverCMD `cmd:"" help:"Discover the feeds advertised by a web page"`
+	OPML     OPMLCMD     `cmd:"" help:"Import or export OPML subscription lists" name:"opml"`
+}
+
+// exitError is an error that should cause the program to exit with a particular exit code.
+type exitError struct {
+	err  error
+	code int
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// ensureNotEUID aborts the program if it is running setuid something, or being invoked by root. It is called from main
+// rather than init so that the tests of this package can be run by root.
+//
+// Following is copied from https://git.kernel.org/pub/scm/libs/libcap/libcap.git/tree/goapps/web/web.go
+func ensureNotEUID() {
+	if euid, uid, egid, gid := syscall.Geteuid(), syscall.Getuid(), syscall.Getegid(), syscall.Getgid(); uid != euid ||
+		gid != egid ||
+		uid == 0 {
+		panic(errors.New("syndicate should not be run with additional privileges or as root"))
+	}
+}
+
+func main() {
+	ensureNotEUID()
+
+	cli := CLI{
+		Globals: Globals{},
+	}
+
+	ctx := kong.Parse(&cli,
+		kong.Name("syndicate"),
+		kong.Description(
+			"syndicate provides a way to view, convert and validate syndicated formats (e.g., RSS, Atom).",
+		),
+		kong.UsageOnError(),
+	)
+
+	if err := ctx.Run(cli.Globals); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: error: %v\n", ctx.Model.Name, err)
+		if exitErr, ok := errors.AsType[*exitError](err); ok {
+			ctx.Exit(exitErr.code)
+		}
+		ctx.Exit(ExitError)
+	}
+}
+
+var client *resty.Client
+
+var LoadHTTPClient = sync.OnceValue(func() *resty.Client {
+	client = resty.New().
+		SetHeader("User-Agent", "go-syndication").
+		SetHeader("Accept", "*/*").
+		SetHeader("Accept-Encoding", "gzip, deflate")
+	return client
+})
+
+// newContext returns a context that is cancelled when the program is interrupted.
+func newContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// loadFeed loads the feed from the given source, which is either a HTTP(S) URL or a path to a file.
+func loadFeed(ctx context.Context, source string) (*feeds.Feed, error) {
+	if isURL(source) {
+		feed, err := feeds.NewFeedFromURL(ctx, source,
+			feeds.WithClient(LoadHTTPClient()),
+			feeds.WithMaxBytes(maxBodySize),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("fetch feed: %w", err)
+		}
+		return feed, nil
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	feed, err := feeds.NewFeedFromReader(file, feeds.WithMaxBytes(maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+	return feed, nil
+}
+
+// isURL reports whether the given source is a HTTP(S) URL rather than a file path.
+func isURL(source string) bool {
+	sourceURL, err := url.Parse(source)
+	if err != nil {
+		return false
+	}
+	return (sourceURL.Scheme == "http" || sourceURL.Scheme == "https") && sourceURL.Host != ""
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/opml"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// OPMLCMD command groups the OPML import and export commands.
+type OPMLCMD struct {
+	Import OPMLImportCMD `cmd:"" help:"List the subscriptions in an OPML file"`
+	Export OPMLExportCMD `cmd:"" help:"Export feeds as an OPML subscription list"`
+}
+
+// OPMLImportCMD command will read the subscriptions from the given OPML file and print them as JSON. Subscriptions
+// nested in folders are flattened, with the folder recorded as their category.
+type OPMLImportCMD struct {
+	File string `arg:"" help:"The OPML file" type:"existingfile"`
+}
+
+// subscription is a feed subscription read from an OPML file.
+type subscription struct {
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	HTMLURL  string `json:"html_url,omitempty"`
+	Category string `json:"category,omitempty"`
+}
+
+func (c *OPMLImportCMD) Run() error {
+	data, err := os.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+	doc, err := opml.NewOPMLFromBytes(data)
+	if err != nil {
+		return fmt.Errorf("parse OPML: %w", err)
+	}
+
+	data, err = encodeJSON(subscriptions(doc.Body, ""))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
+
+// subscriptions returns the subscriptions in the given outlines and any outlines nested within them. Outlines without a
+// feed URL are treated as folders and their text is used as the category of the subscriptions they contain.
+func subscriptions(outlines []opml.Outline, category string) []subscription {
+	subs := []subscription{}
+	for outline := range slices.Values(outlines) {
+		if outline.XMLURL == "" {
+			subs = append(subs, subscriptions(outline.Outlines, firstNonEmpty(outline.Text, category))...)
+			continue
+		}
+		subs = append(subs, subscription{
+			Title:    firstNonEmpty(outline.Title, outline.Text),
+			URL:      outline.XMLURL,
+			HTMLURL:  outline.HTMLURL,
+			Category: firstNonEmpty(outline.Category, category),
+		})
+	}
+	return subs
+}
+
+// OPMLExportCMD command will fetch the feeds at the given URLs and print an OPML subscription list containing them.
+type OPMLExportCMD struct {
+	URLs  []string `arg:"" help:"The URLs of the feeds" name:"url"`
+	Title string   `       help:"The title of the subscription list" default:"Subscriptions"`
+}
+
+func (c *OPMLExportCMD) Run() error {
+	ctx, cancelFunc := newContext()
+	defer cancelFunc()
+
+	outlines := make([]opml.Outline, 0, len(c.URLs))
+	for feedURL := range slices.Values(c.URLs) {
+		feed, err := loadFeed(ctx, feedURL)
+		if err != nil {
+			return fmt.Errorf("%s: %w", feedURL, err)
+		}
+		outlines = append(outlines, *newOutline(feedURL, feed))
+	}
+
+	if err := opml.NewOPML(opml.WithTitle(c.Title), opml.WithOutlines(outlines...)).Write(os.Stdout); err != nil {
+		return fmt.Errorf("encode OPML: %w", err)
+	}
+
+	return nil
+}
+
+// newOutline creates a subscription outline for the given feed, fetched from the given URL.
+func newOutline(feedURL string, feed *feeds.Feed) *opml.Outline {
+	options := []opml.OutlineOption{
+		opml.WithOutlineTitle(feed.GetTitle()),
+	}
+	if link := feed.GetLink(); link != "" {
+		options = append(options, opml.WithHTMLURL(link))
+	}
+	if desc := feed.GetDescription(); desc != "" {
+		options = append(options, opml.WithDescription(desc))
+	}
+	if lang := feed.GetLanguage(); lang != nil {
+		options = append(options, opml.WithLanguage(*lang))
+	}
+	switch feed.SourceType {
+	case types.SourceTypeRSS:
+		options = append(options, opml.WithVersion(opml.OutlineVersionRSS2))
+	case types.SourceTypeRDF:
+		options = append(options, opml.WithVersion(opml.OutlineVersionRSS1))
+	}
+	return opml.NewSubscriptionOutline(feed.GetTitle(), feedURL, options...)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/types"
+	"github.com/immanent-tech/go-syndication/validation"
+)
+
+// errInvalidFeed is returned by the validate command when the feed is invalid.
+var errInvalidFeed = errors.New("feed is invalid")
+
+// ValidateCMD command will validate the feed from the given URL or file and print a report of any problems as JSON.
+// The command exits with ExitOK if the feed is valid, ExitInvalid if it is invalid and ExitError if it could not be
+// fetched or parsed.
+type ValidateCMD struct {
+	Source string `arg:"" help:"The URL or file of the feed"`
+}
+
+// validationReport is the report printed by the validate command.
+type validationReport struct {
+	Source string                  `json:"source"`
+	Type   types.SourceType        `json:"type,omitempty"`
+	Valid  bool                    `json:"valid"`
+	Error  string                  `json:"error,omitempty"`
+	Fields []validation.FieldError `json:"fields,omitempty"`
+}
+
+func (c *ValidateCMD) Run() error {
+	ctx, cancelFunc := newContext()
+	defer cancelFunc()
+
+	feed, err := loadFeed(ctx, c.Source)
+	if err != nil {
+		return &exitError{err: err, code: ExitError}
+	}
+
+	report := validateFeed(c.Source, feed)
+	data, err := encodeJSON(report)
+	if err != nil {
+		return &exitError{err: err, code: ExitError}
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	if !report.Valid {
+		return &exitError{err: errInvalidFeed, code: ExitInvalid}
+	}
+	return nil
+}
+
+// validateFeed validates the given feed and generates a report.
+func validateFeed(source string, feed *feeds.Feed) *validationReport {
+	report := &validationReport{
+		Source: source,
+		Type:   feed.SourceType,
+		Valid:  true,
+	}
+	if err := feed.Validate(); err != nil {
+		report.Valid = false
+		report.Error = err.Error()
+		if structErr, ok := errors.AsType[*validation.StructError](err); ok {
+			report.Fields = structErr.Fields
+		}
+	}
+	return report
+}
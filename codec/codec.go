@@ -0,0 +1,385 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Package codec provides compact binary encodings of feeds.Feed and feeds.Item, for use with message queues and caches
+// where JSON is too bulky. CBOR and MessagePack encodings are supported.
+//
+// Like the JSON form, the encoded data is an envelope containing a schema version, the source type and the source
+// itself, so that data can be decoded back into the correct source type. The source is encoded directly, using the
+// names of its JSON form. Values with a custom JSON form, such as the objects of a JSONFeed (which hold its extension
+// members) and, with MessagePack, dates (whose time zone offsets MessagePack does not keep), are embedded in their JSON
+// form. The envelope also records the version of the JSON schema of the source (see feeds.JSONSchemaVersion), so that
+// data written with an earlier version is migrated as JSON is.
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/extensions/dc"
+	rssext "github.com/immanent-tech/go-syndication/extensions/rss"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// SchemaVersion is the version of the envelope written by this package. Version 1 held the source encoded directly,
+// which lost the extension members of a JSONFeed and, with MessagePack, the time zone offsets of dates. Version 2 held
+// the source as a tree of its JSON form, which kept them but was slower to encode than JSON itself. Neither recorded
+// the version of the JSON schema of the source. Data of every version can still be decoded.
+const SchemaVersion = 3
+
+// jsonSchemaVersions are the versions of the JSON schema of the sources held by the envelope versions that did not
+// record it, by envelope version.
+var jsonSchemaVersions = map[int]int{1: 1, 2: 3}
+
+const (
+	// embeddedJSONTag is the CBOR tag of embedded JSON, as registered with IANA.
+	embeddedJSONTag = 262
+	// embeddedJSONExtID is the MessagePack extension type of embedded JSON.
+	embeddedJSONExtID = 1
+)
+
+var (
+	// CBOR encodes feeds and items as CBOR (RFC 8949).
+	CBOR = &Codec{
+		name:      "CBOR",
+		marshal:   cborEncMode().Marshal,
+		unmarshal: cborDecMode().Unmarshal,
+	}
+	// MsgPack encodes feeds and items as MessagePack.
+	MsgPack = &Codec{
+		name:      "MessagePack",
+		marshal:   msgpackMarshal,
+		unmarshal: msgpackUnmarshal,
+	}
+)
+
+// Codec encodes and decodes feeds and items with a particular encoding.
+type Codec struct {
+	marshal   func(v any) ([]byte, error)
+	unmarshal func(data []byte, v any) error
+	name      string
+}
+
+// envelope wraps an encoded source with the information needed to decode it.
+type envelope struct {
+	SchemaVersion     int              `json:"schema_version"`
+	JSONSchemaVersion int              `json:"json_schema_version,omitempty"`
+	Type              types.SourceType `json:"type"`
+	FeedTitle         string           `json:"feed_title,omitempty"`
+	Source            []byte           `json:"source"`
+}
+
+// jsonEnvelope is the JSON form of a Feed or Item, see feeds.JSONSchemaVersion.
+type jsonEnvelope struct {
+	SchemaVersion int              `json:"schema_version"`
+	Type          types.SourceType `json:"type"`
+	FeedTitle     string           `json:"feed_title,omitempty"`
+	Source        json.RawMessage  `json:"source"`
+}
+
+// MarshalFeed encodes the given Feed.
+func (c *Codec) MarshalFeed(feed *feeds.Feed) ([]byte, error) {
+	return c.marshalEnvelope(feed.SourceType, "", feed.FeedSource)
+}
+
+// UnmarshalFeed decodes a Feed from the given data.
+func (c *Codec) UnmarshalFeed(data []byte) (*feeds.Feed, error) {
+	env, err := c.unmarshalEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+	if env.JSONSchemaVersion != feeds.JSONSchemaVersion {
+		migrated := &feeds.Feed{}
+		if err := c.unmarshalMigrated(env, migrated); err != nil {
+			return nil, err
+		}
+		feed := feeds.NewFeedFromSource(migrated.FeedSource)
+		feed.SourceType = env.Type
+		return feed, nil
+	}
+	var source types.FeedSource
+	switch env.Type {
+	case types.SourceTypeAtom:
+		source, err = unmarshalSource[atom.Feed](c, env)
+	case types.SourceTypeRSS:
+		source, err = unmarshalSource[rss.RSS](c, env)
+	case types.SourceTypeRDF:
+		source, err = unmarshalSource[rdf.RDF](c, env)
+	case types.SourceTypeJSONFeed:
+		source, err = unmarshalSource[jsonfeed.Feed](c, env)
+	default:
+		return nil, fmt.Errorf("%w: unknown data type", feeds.ErrUnmarshal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	feed := feeds.NewFeedFromSource(source)
+	feed.SourceType = env.Type
+	return feed, nil
+}
+
+// MarshalItem encodes the given Item.
+func (c *Codec) MarshalItem(item *feeds.Item) ([]byte, error) {
+	return c.marshalEnvelope(item.SourceType, item.FeedTitle, item.ItemSource)
+}
+
+// UnmarshalItem decodes an Item from the given data.
+func (c *Codec) UnmarshalItem(data []byte) (*feeds.Item, error) {
+	env, err := c.unmarshalEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+	if env.JSONSchemaVersion != feeds.JSONSchemaVersion {
+		item := &feeds.Item{}
+		if err := c.unmarshalMigrated(env, item); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+	var source types.ItemSource
+	switch env.Type {
+	case types.SourceTypeAtom:
+		source, err = unmarshalSource[atom.Entry](c, env)
+	case types.SourceTypeRSS:
+		source, err = unmarshalSource[rss.Item](c, env)
+	case types.SourceTypeRDF:
+		source, err = unmarshalSource[rdf.Item](c, env)
+	case types.SourceTypeJSONFeed:
+		source, err = unmarshalSource[jsonfeed.Item](c, env)
+	default:
+		return nil, fmt.Errorf("%w: unknown data type", feeds.ErrUnmarshal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &feeds.Item{
+		ItemSource: source,
+		SourceType: env.Type,
+		FeedTitle:  env.FeedTitle,
+	}, nil
+}
+
+// marshalEnvelope encodes the given source into an envelope.
+func (c *Codec) marshalEnvelope(sourceType types.SourceType, feedTitle string, source any) ([]byte, error) {
+	data, err := c.marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", feeds.ErrMarshal, c.name, err)
+	}
+	data, err = c.marshal(envelope{
+		SchemaVersion:     SchemaVersion,
+		JSONSchemaVersion: feeds.JSONSchemaVersion,
+		Type:              sourceType,
+		FeedTitle:         feedTitle,
+		Source:            data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", feeds.ErrMarshal, c.name, err)
+	}
+	return data, nil
+}
+
+// unmarshalEnvelope decodes an envelope from the given data. The JSON schema version of envelopes of earlier versions
+// is filled in from the version of the envelope.
+func (c *Codec) unmarshalEnvelope(data []byte) (*envelope, error) {
+	var env envelope
+	if err := c.unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", feeds.ErrUnmarshal, c.name, err)
+	}
+	if env.SchemaVersion != SchemaVersion {
+		version, found := jsonSchemaVersions[env.SchemaVersion]
+		if !found {
+			return nil, fmt.Errorf("%w: unsupported schema version %d", feeds.ErrUnmarshal, env.SchemaVersion)
+		}
+		env.JSONSchemaVersion = version
+	}
+	return &env, nil
+}
+
+// unmarshalSource decodes the source in the given envelope into a new source of type T.
+func unmarshalSource[T any](c *Codec, env *envelope) (*T, error) {
+	source := new(T)
+	if err := c.unmarshal(env.Source, source); err != nil {
+		return nil, fmt.Errorf("%w: unable to unmarshal %s into %T: %w", feeds.ErrUnmarshal, c.name, source, err)
+	}
+	return source, nil
+}
+
+// unmarshalMigrated decodes the source in the given envelope, of an earlier JSON schema version, into the given Feed
+// or Item through its JSON form, so that it is migrated to the current JSON schema version.
+func (c *Codec) unmarshalMigrated(env *envelope, v json.Unmarshaler) error {
+	var value any
+	if err := c.unmarshal(env.Source, &value); err != nil {
+		return fmt.Errorf("%w: unable to unmarshal %s: %w", feeds.ErrUnmarshal, c.name, err)
+	}
+	source, err := json.Marshal(jsonValue(value))
+	if err != nil {
+		return fmt.Errorf("%w: unable to unmarshal %s: %w", feeds.ErrUnmarshal, c.name, err)
+	}
+	data, err := json.Marshal(jsonEnvelope{
+		SchemaVersion: env.JSONSchemaVersion,
+		Type:          env.Type,
+		FeedTitle:     env.FeedTitle,
+		Source:        source,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unable to unmarshal %s: %w", feeds.ErrUnmarshal, c.name, err)
+	}
+	return v.UnmarshalJSON(data)
+}
+
+// jsonValue replaces any embedded JSON in the given decoded value with its raw JSON, so that the value can be
+// marshaled to JSON.
+func jsonValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, elem := range value {
+			value[key] = jsonValue(elem)
+		}
+	case []any:
+		for idx, elem := range value {
+			value[idx] = jsonValue(elem)
+		}
+	case cbor.Tag:
+		if data, ok := value.Content.([]byte); ok && value.Number == embeddedJSONTag {
+			return json.RawMessage(data)
+		}
+	case *embeddedJSON:
+		return json.RawMessage(*value)
+	case embeddedJSON:
+		return json.RawMessage(value)
+	}
+	return value
+}
+
+// cborEncMode returns the CBOR encoding options. Times are encoded as RFC 3339 strings with nanosecond precision,
+// rather than the default of whole Unix seconds, so that they round-trip without loss. Values with a custom JSON form
+// are embedded as JSON.
+func cborEncMode() cbor.EncMode {
+	mode, err := cbor.EncOptions{
+		Time:                    cbor.TimeRFC3339Nano,
+		JSONMarshalerTranscoder: transcoder(embedCBORJSON),
+	}.EncMode()
+	if err != nil {
+		panic(fmt.Sprintf("invalid CBOR encoding options: %v", err))
+	}
+	return mode
+}
+
+// cborDecMode returns the CBOR decoding options. Maps are decoded with string keys, as in JSON, and values with a
+// custom JSON form are decoded from their embedded JSON.
+func cborDecMode() cbor.DecMode {
+	mode, err := cbor.DecOptions{
+		DefaultMapType:            reflect.TypeFor[map[string]any](),
+		JSONUnmarshalerTranscoder: transcoder(extractCBORJSON),
+	}.DecMode()
+	if err != nil {
+		panic(fmt.Sprintf("invalid CBOR decoding options: %v", err))
+	}
+	return mode
+}
+
+// msgpackMarshal encodes the given value as MessagePack, using the JSON struct tags for field names.
+func msgpackMarshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// msgpackUnmarshal decodes the given MessagePack data, using the JSON struct tags for field names.
+func msgpackUnmarshal(data []byte, v any) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	dec.SetCustomStructTag("json")
+	return dec.Decode(v)
+}
+
+// transcoder adapts a function to the cbor.Transcoder interface.
+type transcoder func(dst io.Writer, src io.Reader) error
+
+// Transcode calls the function.
+func (t transcoder) Transcode(dst io.Writer, src io.Reader) error {
+	return t(dst, src)
+}
+
+// embedCBORJSON writes the JSON read from src to dst as embedded JSON in CBOR.
+func embedCBORJSON(dst io.Writer, src io.Reader) error {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	return cbor.NewEncoder(dst).Encode(cbor.Tag{Number: embeddedJSONTag, Content: data})
+}
+
+// extractCBORJSON writes the embedded JSON in the CBOR read from src to dst.
+func extractCBORJSON(dst io.Writer, src io.Reader) error {
+	var tag cbor.Tag
+	if err := cbor.NewDecoder(src).Decode(&tag); err != nil {
+		return err
+	}
+	data, ok := tag.Content.([]byte)
+	if !ok || tag.Number != embeddedJSONTag {
+		return fmt.Errorf("expected embedded JSON, got CBOR tag %d", tag.Number)
+	}
+	_, err := dst.Write(data)
+	return err
+}
+
+// embeddedJSON is JSON embedded in MessagePack, as an extension type.
+type embeddedJSON []byte
+
+// MarshalMsgpack returns the JSON.
+func (j *embeddedJSON) MarshalMsgpack() ([]byte, error) {
+	return *j, nil
+}
+
+// UnmarshalMsgpack sets the JSON.
+func (j *embeddedJSON) UnmarshalMsgpack(data []byte) error {
+	*j = bytes.Clone(data)
+	return nil
+}
+
+func init() {
+	msgpack.RegisterExt(embeddedJSONExtID, (*embeddedJSON)(nil))
+	// MessagePack has no equivalent of json.Marshaler, so values with a custom JSON form, and dates, whose time zone
+	// offsets are not kept by MessagePack, are registered to be embedded as JSON.
+	for value := range slices.Values([]any{
+		jsonfeed.Feed{}, jsonfeed.Item{}, jsonfeed.Author{}, jsonfeed.Attachment{},
+		rss.ItemDescription{}, rssext.ContentEncoded{},
+		rss.Timestamp{}, atom.DateConstruct{}, dc.DCDate{},
+	}) {
+		msgpack.Register(value, encodeMsgpackJSON, decodeMsgpackJSON)
+	}
+}
+
+// encodeMsgpackJSON encodes the given value as embedded JSON in MessagePack.
+func encodeMsgpackJSON(enc *msgpack.Encoder, v reflect.Value) error {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	return enc.Encode((*embeddedJSON)(&data))
+}
+
+// decodeMsgpackJSON decodes the given value from embedded JSON in MessagePack.
+func decodeMsgpackJSON(dec *msgpack.Decoder, v reflect.Value) error {
+	var data embeddedJSON
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v.Addr().Interface())
+}
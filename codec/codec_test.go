@@ -0,0 +1,207 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package codec
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/corpus"
+)
+
+var codecs = map[string]*Codec{
+	"cbor":    CBOR,
+	"msgpack": MsgPack,
+}
+
+func TestCodec(t *testing.T) {
+	samples := []corpus.Sample{
+		{Name: "rss", Data: corpus.RSS(3)},
+		{Name: "atom", Data: corpus.Atom(3)},
+		{Name: "podcast", Data: corpus.Podcast(3)},
+		{Name: "jsonfeed", Data: corpus.JSONFeed(3)},
+	}
+	for name, codec := range codecs {
+		for sample := range slices.Values(samples) {
+			t.Run(name+"/"+sample.Name, func(t *testing.T) {
+				feed, err := feeds.NewFeedFromBytes(sample.Data)
+				require.NoError(t, err)
+
+				data, err := codec.MarshalFeed(feed)
+				require.NoError(t, err)
+				got, err := codec.UnmarshalFeed(data)
+				require.NoError(t, err)
+				assert.Equal(t, feed.SourceType, got.SourceType)
+				assert.IsType(t, feed.FeedSource, got.FeedSource)
+				assert.Equal(t, feed.GetTitle(), got.GetTitle())
+				require.Len(t, got.GetItems(), len(feed.GetItems()))
+
+				item := feed.GetItems()[1]
+				data, err = codec.MarshalItem(&item)
+				require.NoError(t, err)
+				gotItem, err := codec.UnmarshalItem(data)
+				require.NoError(t, err)
+				assert.Equal(t, item.SourceType, gotItem.SourceType)
+				assert.Equal(t, item.FeedTitle, gotItem.FeedTitle)
+				assert.Equal(t, item.GetTitle(), gotItem.GetTitle())
+				assert.Equal(t, item.GetLink(), gotItem.GetLink())
+				assert.True(t, item.GetPublishedDate().Equal(*gotItem.GetPublishedDate()))
+				assert.Equal(t, item.GetContent(), gotItem.GetContent())
+			})
+		}
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	// Every extension fixture that decodes should survive a round trip, as it does through JSON.
+	files, err := filepath.Glob(filepath.Join("..", "test", "assets", "ext", "*", "*.xml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	jsonFeed := []byte(`{"version":"https://jsonfeed.org/version/1.1","title":"Extended",` +
+		`"_ext":{"about":"https://example.com/","id":12345678901234567890,"ratio":0.5},` +
+		`"items":[{"id":"1","date_published":"2003-12-13T08:29:29-04:00","_iext":{"tags":["a","b"]}}]}`)
+	for name, codec := range codecs {
+		t.Run(name+"/jsonfeed extensions", func(t *testing.T) {
+			feed, err := feeds.NewFeedFromBytes(jsonFeed)
+			require.NoError(t, err)
+			data, err := codec.MarshalFeed(feed)
+			require.NoError(t, err)
+			got, err := codec.UnmarshalFeed(data)
+			require.NoError(t, err)
+			assert.Equal(t, feed.FeedSource, got.FeedSource)
+
+			item := feed.GetItems()[0]
+			data, err = codec.MarshalItem(&item)
+			require.NoError(t, err)
+			gotItem, err := codec.UnmarshalItem(data)
+			require.NoError(t, err)
+			assert.Equal(t, item.ItemSource, gotItem.ItemSource)
+		})
+		for file := range slices.Values(files) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			feed, err := feeds.NewFeedFromBytes(data)
+			if err != nil {
+				continue
+			}
+			t.Run(name+"/"+filepath.Base(filepath.Dir(file))+"/"+filepath.Base(file), func(t *testing.T) {
+				data, err := codec.MarshalFeed(feed)
+				require.NoError(t, err)
+				got, err := codec.UnmarshalFeed(data)
+				require.NoError(t, err)
+				assert.Equal(t, feed.FeedSource, got.FeedSource)
+			})
+		}
+	}
+}
+
+func TestCodecMigration(t *testing.T) {
+	// Version 1 data holds the source of JSON schema version 1, with a single <atom:link> in the channel and a single
+	// <enclosure> in each item.
+	item := map[string]any{
+		"title":     "Episode",
+		"enclosure": map[string]any{"url": "https://example.com/a.mp3", "length": 1, "type": "audio/mpeg"},
+	}
+	source := map[string]any{"channel": map[string]any{
+		"title":     "Legacy",
+		"atom_link": map[string]any{"href": "https://example.com/feed.xml", "rel": "self"},
+		"items":     []any{item},
+	}}
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			encode := func(source any, feedTitle string) []byte {
+				data, err := codec.marshal(source)
+				require.NoError(t, err)
+				data, err = codec.marshal(map[string]any{
+					"schema_version": 1,
+					"type":           "RSS",
+					"feed_title":     feedTitle,
+					"source":         data,
+				})
+				require.NoError(t, err)
+				return data
+			}
+
+			feed, err := codec.UnmarshalFeed(encode(source, ""))
+			require.NoError(t, err)
+			assert.Equal(t, "Legacy", feed.GetTitle())
+			assert.Equal(t, "https://example.com/feed.xml", feed.GetSourceURL())
+			require.Len(t, feed.GetItems(), 1)
+			assert.Len(t, feed.GetItems()[0].GetEnclosures(), 1)
+
+			gotItem, err := codec.UnmarshalItem(encode(item, "Legacy"))
+			require.NoError(t, err)
+			assert.Equal(t, "Legacy", gotItem.FeedTitle)
+			assert.Equal(t, "Episode", gotItem.GetTitle())
+			assert.Len(t, gotItem.GetEnclosures(), 1)
+		})
+	}
+}
+
+func TestCodecInvalid(t *testing.T) {
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			_, err := codec.UnmarshalFeed([]byte("not a feed"))
+			require.ErrorIs(t, err, feeds.ErrUnmarshal)
+			_, err = codec.UnmarshalItem(nil)
+			require.ErrorIs(t, err, feeds.ErrUnmarshal)
+		})
+	}
+}
+
+func BenchmarkCodec(b *testing.B) {
+	feed, err := feeds.NewFeedFromBytes(corpus.RSS(100))
+	require.NoError(b, err)
+
+	for name, codec := range codecs {
+		data, err := codec.MarshalFeed(feed)
+		require.NoError(b, err)
+		b.Run(name+"/marshal", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := codec.MarshalFeed(feed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/unmarshal", func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := codec.UnmarshalFeed(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+
+	// JSON, for comparison.
+	data, err := json.Marshal(feed)
+	require.NoError(b, err)
+	b.Run("json/marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(feed); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("json/unmarshal", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		for b.Loop() {
+			var got feeds.Feed
+			if err := json.Unmarshal(data, &got); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
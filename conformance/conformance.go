@@ -0,0 +1,174 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Package conformance runs a corpus of conformance fixtures, such as the feed validator test cases in test/assets,
+// against a feed decoder. Only fixtures with a sidecar expectations file are run, so fixtures can be enabled one at a
+// time as support for them is added. The decoder is passed in, so downstream forks that add formats of their own can
+// run their fixtures in the same way.
+package conformance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/immanent-tech/go-syndication/validation"
+)
+
+// ExpectationSuffix is the suffix of the sidecar file holding the expectations of a fixture, which is named after the
+// fixture (e.g., entry_title_missing.xml.expect.json holds the expectations of entry_title_missing.xml).
+const ExpectationSuffix = ".expect.json"
+
+var (
+	// ErrExpectation indicates a fixture did not decode or validate as its expectations describe.
+	ErrExpectation = errors.New("fixture does not meet expectations")
+	// ErrMissingFixture indicates an expectations file has no fixture next to it.
+	ErrMissingFixture = errors.New("missing fixture")
+)
+
+// Expectation describes the expected result of decoding and validating a fixture.
+type Expectation struct {
+	// Skip is the reason the fixture is skipped, such as a feature that is not yet supported. A skipped fixture is
+	// still listed, so that it is visible in test output.
+	Skip string `json:"skip,omitempty"`
+	// Failures are the validation rules that should fail, as a map of the namespace of the struct field (e.g.,
+	// "Feed.Entries[0].Authors[0].Email") to the tags that should fail for it (e.g., "email"). Other rules may fail
+	// as well. If there are any failures, the fixture is expected to be invalid.
+	Failures map[string][]string `json:"failures,omitempty"`
+	// DecodeError is whether decoding the fixture should fail.
+	DecodeError bool `json:"decode_error,omitempty"`
+	// Invalid is whether validating the decoded fixture should fail. Otherwise, the fixture should be valid.
+	Invalid bool `json:"invalid,omitempty"`
+}
+
+// Fixture is a single conformance fixture with its expectations.
+type Fixture struct {
+	// Name is the path of the fixture relative to the directory it was loaded from.
+	Name string
+	// Path is the path of the fixture.
+	Path string
+	// Expectation is the expected result of decoding and validating the fixture.
+	Expectation Expectation
+}
+
+// Validator is a decoded fixture that can be validated.
+type Validator interface {
+	Validate() error
+}
+
+// DecodeFunc decodes the data of a fixture.
+type DecodeFunc func(data []byte) (Validator, error)
+
+// Load loads the fixtures in the given directory (and its subdirectories) that have an expectations file. Files
+// without one are ignored.
+func Load(dir string) ([]Fixture, error) {
+	var fixtures []Fixture
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ExpectationSuffix) {
+			return nil
+		}
+		data, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return fmt.Errorf("read expectations: %w", err)
+		}
+		var expectation Expectation
+		if err := json.Unmarshal(data, &expectation); err != nil {
+			return fmt.Errorf("decode expectations %s: %w", path, err)
+		}
+		fixture := strings.TrimSuffix(path, ExpectationSuffix)
+		if _, err := os.Stat(fixture); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrMissingFixture, fixture, err)
+		}
+		fixtures = append(fixtures, Fixture{
+			Name:        strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(fixture, dir)), "/"),
+			Path:        fixture,
+			Expectation: expectation,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load fixtures: %w", err)
+	}
+	return fixtures, nil
+}
+
+// Check decodes and validates the fixture with the given DecodeFunc, returning an ErrExpectation error if the result
+// does not match its expectations.
+func (f *Fixture) Check(decode DecodeFunc) error {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return fmt.Errorf("read fixture: %w", err)
+	}
+	feed, err := decode(data)
+	switch {
+	case f.Expectation.DecodeError && err == nil:
+		return fmt.Errorf("%w: decoded without error", ErrExpectation)
+	case f.Expectation.DecodeError:
+		return nil
+	case err != nil:
+		return fmt.Errorf("%w: decode: %w", ErrExpectation, err)
+	}
+
+	err = feed.Validate()
+	if !f.Expectation.Invalid && len(f.Expectation.Failures) == 0 {
+		if err != nil {
+			return fmt.Errorf("%w: invalid: %w", ErrExpectation, err)
+		}
+		return nil
+	}
+	if err == nil {
+		return fmt.Errorf("%w: valid", ErrExpectation)
+	}
+	failed := failedValidations(err)
+	for namespace, tags := range f.Expectation.Failures {
+		for tag := range slices.Values(tags) {
+			if !slices.Contains(failed[namespace], tag) {
+				return fmt.Errorf("%w: %s did not fail validation for %s: %w", ErrExpectation, namespace, tag, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Run runs the fixtures in the given directories as subtests of the given test, decoding them with the given
+// DecodeFunc.
+func Run(t *testing.T, decode DecodeFunc, dirs ...string) {
+	t.Helper()
+	for dir := range slices.Values(dirs) {
+		fixtures, err := Load(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for fixture := range slices.Values(fixtures) {
+			t.Run(filepath.ToSlash(filepath.Join(dir, fixture.Name)), func(t *testing.T) {
+				if fixture.Expectation.Skip != "" {
+					t.Skip(fixture.Expectation.Skip)
+				}
+				if err := fixture.Check(decode); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
+
+// failedValidations returns the tags of the validation rules that failed in the given error, by the namespace of the
+// struct field they failed for.
+func failedValidations(err error) map[string][]string {
+	failed := make(map[string][]string)
+	if structErr, ok := errors.AsType[*validation.StructError](err); ok && structErr != nil {
+		for field := range slices.Values(structErr.Fields) {
+			failed[field.StructNamespace] = append(failed[field.StructNamespace], field.Tag)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package conformance
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/validation"
+)
+
+// fixture is a decoded fixture for testing, which is valid if it has no validation failures.
+type fixture struct {
+	Failures []validation.FieldError
+}
+
+func (f *fixture) Validate() error {
+	if len(f.Failures) == 0 {
+		return nil
+	}
+	return &validation.StructError{Fields: f.Failures}
+}
+
+func decodeFixture(data []byte) (Validator, error) {
+	var decoded fixture
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "valid.json"), []byte(`{}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "valid.json"+ExpectationSuffix), []byte(`{}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "disabled.json"), []byte(`{}`), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "broken.json"), []byte(`{`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "broken.json"+ExpectationSuffix),
+		[]byte(`{"decode_error": true}`), 0o600))
+
+	fixtures, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 2)
+	assert.Equal(t, "sub/broken.json", fixtures[0].Name)
+	assert.True(t, fixtures[0].Expectation.DecodeError)
+	assert.Equal(t, "valid.json", fixtures[1].Name)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "missing.json"+ExpectationSuffix), []byte(`{}`), 0o600))
+	_, err = Load(dir)
+	require.ErrorIs(t, err, ErrMissingFixture)
+}
+
+func TestFixtureCheck(t *testing.T) {
+	invalid := `{"Failures": [{"StructNamespace": "Feed.Link", "Tag": "url"}]}`
+	tests := map[string]struct {
+		data        string
+		expectation Expectation
+		wantErr     bool
+	}{
+		"valid": {
+			data: `{}`,
+		},
+		"unexpectedly invalid": {
+			data:    invalid,
+			wantErr: true,
+		},
+		"invalid": {
+			data:        invalid,
+			expectation: Expectation{Invalid: true},
+		},
+		"unexpectedly valid": {
+			data:        `{}`,
+			expectation: Expectation{Invalid: true},
+			wantErr:     true,
+		},
+		"failures": {
+			data:        invalid,
+			expectation: Expectation{Failures: map[string][]string{"Feed.Link": {"url"}}},
+		},
+		"other failures": {
+			data:        invalid,
+			expectation: Expectation{Failures: map[string][]string{"Feed.Title": {"required"}}},
+			wantErr:     true,
+		},
+		"decode error": {
+			data:        `{`,
+			expectation: Expectation{DecodeError: true},
+		},
+		"unexpected decode error": {
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "fixture.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.data), 0o600))
+			fixture := Fixture{Name: "fixture.json", Path: path, Expectation: tt.expectation}
+			err := fixture.Check(decodeFixture)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrExpectation)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFixtureCheckMissing(t *testing.T) {
+	fixture := Fixture{Path: filepath.Join(t.TempDir(), "missing.json")}
+	err := fixture.Check(decodeFixture)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrExpectation)
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package conformance
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/extensions"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	xmlNameType  = reflect.TypeFor[xml.Name]()
+	xmlAttrsType = reflect.TypeFor[[]xml.Attr]()
+	// namespacesType is the type of the namespaces declared by a feed, which are compared in any order.
+	namespacesType = reflect.TypeFor[[]extensions.Namespace]()
+)
+
+// Equal reports whether the given values are semantically equal, see Diff.
+func Equal(want, got any) bool {
+	return len(Diff(want, got)) == 0
+}
+
+// Diff compares the given values semantically and returns a description of each difference, prefixed by the path to
+// the differing value (e.g., "Channel.Items[0].Title"), in order of path. Unlike reflect.DeepEqual, it ignores
+// differences that do not survive encoding: nil and empty slices, maps and pointers to zero values are equal, times are
+// equal if they are the same instant, only the local name of an xml.Name is compared, namespace declarations in a slice
+// of xml.Attr are ignored, the namespaces of a feed are compared in any order, and unexported fields are ignored.
+func Diff(want, got any) []string {
+	diffs := diffValues("", reflect.ValueOf(want), reflect.ValueOf(got), nil)
+	slices.Sort(diffs)
+	return diffs
+}
+
+// diffValues appends the differences between the given values at the given path to the given differences.
+func diffValues(path string, want, got reflect.Value, diffs []string) []string {
+	if isZero(want) && isZero(got) {
+		return diffs
+	}
+	if !want.IsValid() || !got.IsValid() || want.Type() != got.Type() {
+		return append(diffs, describe(path, want, got))
+	}
+	switch want.Type() {
+	case timeType:
+		wantTime, _ := want.Interface().(time.Time)
+		gotTime, _ := got.Interface().(time.Time)
+		if !wantTime.Equal(gotTime) {
+			return append(diffs, describe(path, want, got))
+		}
+		return diffs
+	case xmlNameType:
+		if want.FieldByName("Local").String() != got.FieldByName("Local").String() {
+			return append(diffs, describe(path, want, got))
+		}
+		return diffs
+	case xmlAttrsType:
+		wantAttrs, _ := want.Interface().([]xml.Attr)
+		gotAttrs, _ := got.Interface().([]xml.Attr)
+		return diffElements(path, reflect.ValueOf(withoutNamespaceDecls(wantAttrs)),
+			reflect.ValueOf(withoutNamespaceDecls(gotAttrs)), diffs)
+	case namespacesType:
+		wantNamespaces, _ := want.Interface().([]extensions.Namespace)
+		gotNamespaces, _ := got.Interface().([]extensions.Namespace)
+		return diffElements(path, reflect.ValueOf(sortedNamespaces(wantNamespaces)),
+			reflect.ValueOf(sortedNamespaces(gotNamespaces)), diffs)
+	}
+	switch want.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return diffValues(path, want.Elem(), got.Elem(), diffs)
+	case reflect.Slice, reflect.Array:
+		return diffElements(path, want, got, diffs)
+	case reflect.Map:
+		keys := slices.Collect(want.Seq())
+		for key := range got.Seq() {
+			if !want.MapIndex(key).IsValid() {
+				keys = append(keys, key)
+			}
+		}
+		for key := range slices.Values(keys) {
+			diffs = diffValues(fmt.Sprintf("%s[%v]", path, key), want.MapIndex(key), got.MapIndex(key), diffs)
+		}
+		return diffs
+	case reflect.Struct:
+		for idx := range want.NumField() {
+			field := want.Type().Field(idx)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			diffs = diffValues(fieldPath, want.Field(idx), got.Field(idx), diffs)
+		}
+		return diffs
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return diffs
+	default:
+		if want.Interface() != got.Interface() {
+			return append(diffs, describe(path, want, got))
+		}
+		return diffs
+	}
+}
+
+// diffElements appends the differences between the elements of the given slices or arrays at the given path to the
+// given differences.
+func diffElements(path string, want, got reflect.Value, diffs []string) []string {
+	if want.Len() != got.Len() {
+		return append(diffs, fmt.Sprintf("%s: want %d elements, got %d", pathOrRoot(path), want.Len(), got.Len()))
+	}
+	for idx := range want.Len() {
+		diffs = diffValues(fmt.Sprintf("%s[%d]", path, idx), want.Index(idx), got.Index(idx), diffs)
+	}
+	return diffs
+}
+
+// withoutNamespaceDecls returns the given attributes without any namespace declarations, which are written by the
+// encoder as needed, so whether they are kept as attributes when decoding depends on the document.
+func withoutNamespaceDecls(attrs []xml.Attr) []xml.Attr {
+	return slices.DeleteFunc(slices.Clone(attrs), func(attr xml.Attr) bool {
+		return attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") ||
+			strings.HasPrefix(attr.Name.Local, "xmlns:")
+	})
+}
+
+// sortedNamespaces returns a copy of the given namespaces sorted by prefix and URI.
+func sortedNamespaces(namespaces []extensions.Namespace) []extensions.Namespace {
+	return slices.SortedFunc(slices.Values(namespaces), func(a, b extensions.Namespace) int {
+		return cmp.Or(cmp.Compare(a.Prefix, b.Prefix), cmp.Compare(a.URI, b.URI))
+	})
+}
+
+// isZero reports whether the given value is invalid, the zero value of its type, an empty slice or map, or a pointer
+// or interface holding such a value.
+func isZero(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil() || isZero(value.Elem())
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Struct:
+		if value.Type() == timeType {
+			return value.IsZero()
+		}
+		for idx := range value.NumField() {
+			if value.Type().Field(idx).IsExported() && !isZero(value.Field(idx)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return value.IsZero()
+	}
+}
+
+// describe describes the difference between the given values at the given path.
+func describe(path string, want, got reflect.Value) string {
+	return fmt.Sprintf("%s: want %s, got %s", pathOrRoot(path), format(want), format(got))
+}
+
+// format formats the given value for a description of a difference.
+func format(value reflect.Value) string {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || !value.CanInterface() {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%#v", value.Interface())
+}
+
+// pathOrRoot returns the given path, or a name for the root value if it is empty.
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+	return path
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package conformance
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/immanent-tech/go-syndication/extensions"
+)
+
+type diffTest struct {
+	XMLName    xml.Name
+	Updated    time.Time
+	Title      *string
+	Attributes []xml.Attr
+	Namespaces []extensions.Namespace
+	Tags       map[string]int
+	Items      []diffTest
+	hidden     string
+}
+
+func TestDiff(t *testing.T) {
+	updated := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	tests := map[string]struct {
+		want      diffTest
+		got       diffTest
+		wantDiffs []string
+	}{
+		"equal": {
+			want: diffTest{Title: new("Title"), Tags: map[string]int{"a": 1}, Items: []diffTest{{Title: new("Item")}}},
+			got:  diffTest{Title: new("Title"), Tags: map[string]int{"a": 1}, Items: []diffTest{{Title: new("Item")}}},
+		},
+		"empty values": {
+			want: diffTest{Title: new(""), Tags: map[string]int{}, Items: []diffTest{}},
+			got:  diffTest{},
+		},
+		"same instant": {
+			want: diffTest{Updated: updated},
+			got:  diffTest{Updated: updated.In(time.FixedZone("+10", 10*60*60))},
+		},
+		"namespaces": {
+			want: diffTest{
+				XMLName: xml.Name{Local: "feed"},
+				Attributes: []xml.Attr{
+					{Name: xml.Name{Local: "xmlns"}, Value: "http://www.w3.org/2005/Atom"},
+					{Name: xml.Name{Local: "lang"}, Value: "en"},
+				},
+				Namespaces: []extensions.Namespace{{Prefix: "media", URI: "media"}, {Prefix: "dc", URI: "dc"}},
+			},
+			got: diffTest{
+				XMLName: xml.Name{Space: "http://www.w3.org/2005/Atom", Local: "feed"},
+				Attributes: []xml.Attr{
+					{Name: xml.Name{Local: "lang"}, Value: "en"},
+					{Name: xml.Name{Space: "xmlns", Local: "dc"}, Value: "dc"},
+				},
+				Namespaces: []extensions.Namespace{{Prefix: "dc", URI: "dc"}, {Prefix: "media", URI: "media"}},
+			},
+		},
+		"unexported": {
+			want: diffTest{hidden: "a"},
+			got:  diffTest{hidden: "b"},
+		},
+		"different": {
+			want: diffTest{
+				Updated: updated,
+				Title:   new("Title"),
+				Tags:    map[string]int{"a": 1},
+				Items:   []diffTest{{Title: new("One")}, {Title: new("Two")}},
+			},
+			got: diffTest{
+				Updated: updated.Add(time.Second),
+				Tags:    map[string]int{"a": 2, "b": 1},
+				Items:   []diffTest{{Title: new("One")}},
+			},
+			wantDiffs: []string{
+				"Items: want 2 elements, got 1",
+				`Tags[a]: want 1, got 2`,
+				`Tags[b]: want <nil>, got 1`,
+				`Title: want "Title", got <nil>`,
+				`Updated: want time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC), ` +
+					`got time.Date(2026, time.January, 2, 3, 4, 6, 0, time.UTC)`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.wantDiffs, Diff(tt.want, tt.got))
+			assert.Equal(t, len(tt.wantDiffs) == 0, Equal(tt.want, tt.got))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// ErrRoundTrip indicates a document did not decode to the same value after it was encoded and decoded again.
+var ErrRoundTrip = errors.New("document does not survive a round trip")
+
+// Codec decodes and encodes documents of a format as values of type T.
+type Codec[T any] struct {
+	// Decode decodes a document.
+	Decode func(data []byte) (T, error)
+	// Encode encodes a decoded value as a document.
+	Encode func(value T) ([]byte, error)
+}
+
+// RoundTrip decodes the given document with the given Codec, encodes the result and decodes that again. If the two
+// decoded values are not equal (see Diff), an ErrRoundTrip error listing the differences is returned. Errors decoding
+// the given document are returned as is, so that documents the Codec does not support can be told apart.
+func RoundTrip[T any](codec Codec[T], data []byte) error {
+	decoded, err := codec.Decode(data)
+	if err != nil {
+		return err
+	}
+	encoded, err := codec.Encode(decoded)
+	if err != nil {
+		return fmt.Errorf("%w: encode: %w", ErrRoundTrip, err)
+	}
+	redecoded, err := codec.Decode(encoded)
+	if err != nil {
+		return fmt.Errorf("%w: decode encoded document: %w", ErrRoundTrip, err)
+	}
+	if diffs := Diff(decoded, redecoded); len(diffs) > 0 {
+		return fmt.Errorf("%w:\n%s", ErrRoundTrip, strings.Join(diffs, "\n"))
+	}
+	return nil
+}
+
+// LoadAll loads every fixture in the given directory (and its subdirectories), with the expectations in its sidecar
+// file, if it has one, see Load.
+func LoadAll(dir string) ([]Fixture, error) {
+	withExpectations, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures []Fixture
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasSuffix(path, ExpectationSuffix) {
+			return nil
+		}
+		idx := slices.IndexFunc(withExpectations, func(fixture Fixture) bool { return fixture.Path == path })
+		if idx >= 0 {
+			fixtures = append(fixtures, withExpectations[idx])
+			return nil
+		}
+		fixtures = append(fixtures, Fixture{
+			Name: strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(path, dir)), "/"),
+			Path: path,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load fixtures: %w", err)
+	}
+	return fixtures, nil
+}
+
+// RunRoundTrip runs a round trip (see RoundTrip) of every fixture in the given directories with the given Codec, as
+// subtests of the given test. Fixtures that are expected to fail to decode, or that the Codec cannot decode, are
+// skipped.
+func RunRoundTrip[T any](t *testing.T, codec Codec[T], dirs ...string) {
+	t.Helper()
+	for dir := range slices.Values(dirs) {
+		fixtures, err := LoadAll(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for fixture := range slices.Values(fixtures) {
+			t.Run(filepath.ToSlash(filepath.Join(dir, fixture.Name)), func(t *testing.T) {
+				switch {
+				case fixture.Expectation.Skip != "":
+					t.Skip(fixture.Expectation.Skip)
+				case fixture.Expectation.DecodeError:
+					t.Skip("fixture is expected to fail to decode")
+				}
+				data, err := os.ReadFile(fixture.Path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = RoundTrip(codec, data)
+				switch {
+				case err == nil:
+				case errors.Is(err, ErrRoundTrip):
+					t.Error(err)
+				default:
+					t.Skipf("cannot decode fixture: %v", err)
+				}
+			})
+		}
+	}
+}
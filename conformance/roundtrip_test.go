@@ -0,0 +1,68 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package conformance
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripTest struct {
+	Title string `json:"title"`
+	Lossy string `json:"lossy"`
+}
+
+// roundTripCodec is a JSON codec that loses the Lossy field when encoding, if lossy is set.
+func roundTripCodec(lossy bool) Codec[*roundTripTest] {
+	return Codec[*roundTripTest]{
+		Decode: func(data []byte) (*roundTripTest, error) {
+			var decoded roundTripTest
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				return nil, err
+			}
+			return &decoded, nil
+		},
+		Encode: func(value *roundTripTest) ([]byte, error) {
+			encoded := *value
+			if lossy {
+				encoded.Lossy = ""
+			}
+			return json.Marshal(encoded)
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := []byte(`{"title": "Title", "lossy": "Lost"}`)
+	require.NoError(t, RoundTrip(roundTripCodec(false), data))
+
+	err := RoundTrip(roundTripCodec(true), data)
+	require.ErrorIs(t, err, ErrRoundTrip)
+	assert.Contains(t, err.Error(), `Lossy: want "Lost", got ""`)
+
+	err = RoundTrip(roundTripCodec(false), []byte(`{`))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrRoundTrip)
+}
+
+func TestLoadAll(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"+ExpectationSuffix),
+		[]byte(`{"decode_error": true}`), 0o600))
+
+	fixtures, err := LoadAll(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 2)
+	assert.Equal(t, "a.json", fixtures[0].Name)
+	assert.Equal(t, "b.json", fixtures[1].Name)
+	assert.False(t, fixtures[0].Expectation.DecodeError)
+	assert.True(t, fixtures[1].Expectation.DecodeError)
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/conformance"
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+)
+
+// feedCodec returns a Codec that decodes feeds of any format with the given options, and encodes them in their own
+// format.
+func feedCodec(options ...feeds.Option) conformance.Codec[*feeds.Feed] {
+	return conformance.Codec[*feeds.Feed]{
+		Decode: func(data []byte) (*feeds.Feed, error) {
+			return feeds.NewFeedFromBytes(data, options...)
+		},
+		Encode: func(feed *feeds.Feed) ([]byte, error) {
+			if source, ok := feed.FeedSource.(*jsonfeed.Feed); ok {
+				return json.Marshal(source)
+			}
+			return feeds.Encode(feed.FeedSource)
+		},
+	}
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(data []byte) (conformance.Validator, error) {
+		return feeds.NewFeedFromBytes(data)
+	}, "test/assets/atom", "test/assets/rss", "test/assets/rss20")
+}
+
+func TestRoundTrip(t *testing.T) {
+	// Only valid fixtures can be expected to survive a round trip, as the encoders refuse to write some invalid values.
+	conformance.RunRoundTrip(t, feedCodec(feeds.WithValidation()), "test/assets")
+	for sample := range slices.Values(corpus.Samples()) {
+		t.Run("corpus/"+sample.Name, func(t *testing.T) {
+			require.NoError(t, conformance.RoundTrip(feedCodec(), sample.Data))
+		})
+	}
+}
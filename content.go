@@ -0,0 +1,72 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/immanent-tech/go-syndication/sanitization"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// duplicateThreshold is the minimum ratio of the length of the shorter text to the longer text for the shorter text to
+// be considered a truncated copy of the longer text.
+const duplicateThreshold = 0.9
+
+// strictPolicy is the policy used to strip all markup from content.
+var strictPolicy = sync.OnceValue(bluemonday.StrictPolicy)
+
+// truncationMarkers are suffixes commonly appended by feed generators to truncated text, in lower-case.
+var truncationMarkers = []string{"[…]", "[...]", "…", "...", "read more", "continue reading"}
+
+// IsDuplicateContent reports whether the given description and content of an item are (near) duplicates of each other.
+// Both values are compared as plain text, ignoring any markup, whitespace and case differences. The values are
+// considered duplicates if they are identical or the description is a lightly truncated copy of the content (or vice
+// versa).
+func IsDuplicateContent(description, content string) bool {
+	description, content = normalizeText(description), normalizeText(content)
+	if description == "" || content == "" {
+		return false
+	}
+	if description == content {
+		return true
+	}
+	shorter, longer := trimTruncationMarkers(description), trimTruncationMarkers(content)
+	if shorter == "" || longer == "" {
+		return false
+	}
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	return strings.HasPrefix(longer, shorter) &&
+		float64(len(shorter)) >= float64(len(longer))*duplicateThreshold
+}
+
+// trimTruncationMarkers removes any truncation markers, such as "…" or "read more", from the end of the given
+// normalized text.
+func trimTruncationMarkers(text string) string {
+	for {
+		trimmed := text
+		for marker := range slices.Values(truncationMarkers) {
+			trimmed = strings.TrimSpace(strings.TrimSuffix(trimmed, marker))
+		}
+		if trimmed == text {
+			return text
+		}
+		text = trimmed
+	}
+}
+
+// plainText strips any markup from the given HTML content, returning only the text.
+func plainText(content string) string {
+	return sanitization.SanitizeString(content, sanitization.WithPolicy(strictPolicy()))
+}
+
+// normalizeText converts the given HTML content into plain text suitable for comparison, with all whitespace collapsed
+// and in lower-case.
+func normalizeText(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(plainText(content)), " "))
+}
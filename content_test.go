@@ -0,0 +1,105 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func TestIsDuplicateContent(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog. The dog did not seem to mind at all, and went back to sleep."
+	tests := []struct {
+		name        string
+		description string
+		content     string
+		want        bool
+	}{
+		{
+			name:        "identical",
+			description: "<p>" + text + "</p>",
+			content:     "<p>" + text + "</p>",
+			want:        true,
+		},
+		{
+			name:        "different markup",
+			description: text,
+			content: "<div><p>The quick brown fox jumps over the <b>lazy</b> dog.</p>\n<p>The dog did not seem to mind at " +
+				"all, and went back to sleep.</p></div>",
+			want: true,
+		},
+		{
+			name:        "truncated",
+			description: text[:len(text)-5] + " […]",
+			content:     text,
+			want:        true,
+		},
+		{
+			name:        "truncated content",
+			description: text,
+			content:     "<p>" + text[:len(text)-5] + "…</p>",
+			want:        true,
+		},
+		{
+			name:        "read more",
+			description: text[:len(text)-5] + `... <a href="https://example.com/">Read more</a>`,
+			content:     text,
+			want:        true,
+		},
+		{
+			name:        "only markers",
+			description: "...",
+			content:     "Read more",
+			want:        false,
+		},
+		{
+			name:        "summary",
+			description: "A fox and a dog.",
+			content:     text,
+			want:        false,
+		},
+		{
+			name:        "excerpt",
+			description: text[:len(text)/2] + "…",
+			content:     text,
+			want:        false,
+		},
+		{
+			name:    "empty",
+			content: text,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsDuplicateContent(tt.description, tt.content))
+		})
+	}
+}
+
+func TestWithDescriptionDeduplication(t *testing.T) {
+	text := "<p>The quick brown fox jumps over the lazy dog.</p>"
+	source := &rss.RSS{
+		Channel: rss.Channel{
+			Title: "Test",
+			Items: []rss.Item{
+				*rss.NewItem(rss.WithItemDescription(text, true), rss.WithItemContent(text, true)),
+				*rss.NewItem(rss.WithItemDescription("A fox.", false), rss.WithItemContent(text, true)),
+			},
+		},
+	}
+
+	items := NewFeedFromSource(source, WithDescriptionDeduplication()).GetItems()
+	require.Len(t, items, 2)
+	assert.Empty(t, items[0].GetDescription())
+	assert.Equal(t, "A fox.", items[1].GetDescription())
+
+	items = NewFeedFromSource(source).GetItems()
+	require.Len(t, items, 2)
+	assert.Equal(t, text, items[0].GetDescription())
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Package corpus provides a corpus of feed data for benchmarking feed parsing. It contains generated feeds of various
+// formats and sizes, and can load additional feeds from disk, so that downstream packages can benchmark against the
+// same data as this package.
+package corpus
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// epoch is the date used as the base for all generated dates, so the generated feeds are stable.
+var epoch = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+// Sample is a single feed in the corpus.
+type Sample struct {
+	// Name is a descriptive name for the sample.
+	Name string
+	// Format is the format of the feed data.
+	Format types.SourceType
+	// Data is the raw feed data.
+	Data []byte
+}
+
+// Samples returns the standard set of generated samples: a small RSS feed, a large Atom feed, a huge podcast feed and a
+// JSON feed.
+func Samples() []Sample {
+	return []Sample{
+		{Name: "small-rss", Format: types.SourceTypeRSS, Data: RSS(10)},
+		{Name: "large-atom", Format: types.SourceTypeAtom, Data: Atom(1000)},
+		{Name: "huge-podcast", Format: types.SourceTypeRSS, Data: Podcast(5000)},
+		{Name: "jsonfeed", Format: types.SourceTypeJSONFeed, Data: JSONFeed(100)},
+	}
+}
+
+// Load loads every file in the given directory (and its subdirectories) as a Sample. The format of each file is
+// determined from its content with feeds.DetectFormat. Files that are not a feed, or whose format cannot be determined,
+// are skipped.
+func Load(dir string) ([]Sample, error) {
+	var samples []Sample
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		data, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return fmt.Errorf("read sample: %w", err)
+		}
+		format := feeds.DetectFormat(data)
+		if format == types.SourceTypeUnknown || format == types.SourceTypeHTML {
+			return nil
+		}
+		samples = append(samples, Sample{
+			Name:   strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(path, dir)), "/"),
+			Format: format,
+			Data:   data,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load corpus: %w", err)
+	}
+	return samples, nil
+}
+
+// RSS generates an RSS 2.0 feed with the given number of items.
+func RSS(items int) []byte {
+	var data bytes.Buffer
+	data.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+  <channel>
+    <title>Example Feed</title>
+    <link>https://example.com/</link>
+    <description>An example RSS feed.</description>
+    <language>en</language>
+    <lastBuildDate>` + epoch.Format(time.RFC1123Z) + `</lastBuildDate>`)
+	for idx := range items {
+		fmt.Fprintf(&data, `
+    <item>
+      <title>Item %[1]d</title>
+      <link>https://example.com/items/%[1]d</link>
+      <guid isPermaLink="true">https://example.com/items/%[1]d</guid>
+      <pubDate>%[2]s</pubDate>
+      <dc:creator>Alice</dc:creator>
+      <category>Example</category>
+      <description>&lt;p&gt;The summary of item %[1]d.&lt;/p&gt;</description>
+      <content:encoded><![CDATA[%[3]s]]></content:encoded>
+    </item>`, idx, epoch.Add(-time.Duration(idx)*time.Hour).Format(time.RFC1123Z), content(idx))
+	}
+	data.WriteString(`
+  </channel>
+</rss>
+`)
+	return data.Bytes()
+}
+
+// Atom generates an Atom feed with the given number of entries.
+func Atom(entries int) []byte {
+	var data bytes.Buffer
+	data.WriteString(`<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="en">
+  <title>Example Feed</title>
+  <subtitle>An example Atom feed.</subtitle>
+  <link href="https://example.com/"/>
+  <link rel="self" href="https://example.com/feed.atom"/>
+  <id>https://example.com/</id>
+  <updated>` + epoch.Format(time.RFC3339) + `</updated>
+  <author><name>Alice</name></author>`)
+	for idx := range entries {
+		fmt.Fprintf(&data, `
+  <entry>
+    <title>Entry %[1]d</title>
+    <link href="https://example.com/entries/%[1]d"/>
+    <id>https://example.com/entries/%[1]d</id>
+    <updated>%[2]s</updated>
+    <published>%[2]s</published>
+    <category term="example"/>
+    <summary>The summary of entry %[1]d.</summary>
+    <content type="html">%[3]s</content>
+  </entry>`, idx, epoch.Add(-time.Duration(idx)*time.Hour).Format(time.RFC3339), escape(content(idx)))
+	}
+	data.WriteString(`
+</feed>
+`)
+	return data.Bytes()
+}
+
+// Podcast generates an RSS 2.0 podcast feed, using the iTunes extension, with the given number of episodes.
+func Podcast(episodes int) []byte {
+	var data bytes.Buffer
+	data.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+  <channel>
+    <title>Example Podcast</title>
+    <link>https://example.com/podcast</link>
+    <description>An example podcast feed.</description>
+    <language>en</language>
+    <itunes:author>Alice</itunes:author>
+    <itunes:image href="https://example.com/podcast.jpg"/>
+    <itunes:category text="Technology"/>
+    <itunes:explicit>false</itunes:explicit>`)
+	for idx := range episodes {
+		fmt.Fprintf(&data, `
+    <item>
+      <title>Episode %[1]d</title>
+      <link>https://example.com/podcast/%[1]d</link>
+      <guid isPermaLink="false">episode-%[1]d</guid>
+      <pubDate>%[2]s</pubDate>
+      <description>&lt;p&gt;The show notes of episode %[1]d.&lt;/p&gt;</description>
+      <enclosure url="https://example.com/podcast/%[1]d.mp3" length="%[3]d" type="audio/mpeg"/>
+      <itunes:duration>%[4]d</itunes:duration>
+      <itunes:episode>%[1]d</itunes:episode>
+      <itunes:explicit>false</itunes:explicit>
+    </item>`, idx, epoch.Add(-time.Duration(idx)*7*24*time.Hour).Format(time.RFC1123Z), 1000000+idx, 1800+idx)
+	}
+	data.WriteString(`
+  </channel>
+</rss>
+`)
+	return data.Bytes()
+}
+
+// JSONFeed generates a JSON Feed with the given number of items.
+func JSONFeed(items int) []byte {
+	var data bytes.Buffer
+	data.WriteString(`{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Example Feed",
+  "home_page_url": "https://example.com/",
+  "feed_url": "https://example.com/feed.json",
+  "description": "An example JSON feed.",
+  "language": "en",
+  "authors": [{"name": "Alice"}],
+  "items": [`)
+	for idx := range items {
+		if idx > 0 {
+			data.WriteString(",")
+		}
+		fmt.Fprintf(&data, `
+    {
+      "id": "https://example.com/items/%[1]d",
+      "url": "https://example.com/items/%[1]d",
+      "title": "Item %[1]d",
+      "summary": "The summary of item %[1]d.",
+      "content_html": %[3]q,
+      "date_published": %[2]q,
+      "tags": ["example"]
+    }`, idx, epoch.Add(-time.Duration(idx)*time.Hour).Format(time.RFC3339), content(idx))
+	}
+	data.WriteString(`
+  ]
+}
+`)
+	return data.Bytes()
+}
+
+// content generates some HTML content for the item with the given index.
+func content(idx int) string {
+	lines := make([]string, 0, 4)
+	for paragraph := range 3 {
+		lines = append(lines, fmt.Sprintf(
+			`<p>Paragraph %d of item %d. The <b>quick</b> brown fox jumps over the <a href="https://example.com/">lazy`+
+				` dog</a>.</p>`, paragraph, idx))
+	}
+	lines = append(lines, fmt.Sprintf(`<img src="https://example.com/images/%d.jpg" alt="Image %d">`, idx, idx))
+	return strings.Join(lines, "\n")
+}
+
+// escape escapes the given text for use in XML.
+func escape(text string) string {
+	var escaped bytes.Buffer
+	if err := xml.EscapeText(&escaped, []byte(text)); err != nil {
+		return text
+	}
+	return escaped.String()
+}
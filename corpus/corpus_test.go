@@ -0,0 +1,47 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package corpus
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "feed.rss"), RSS(1), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "feed.atom"), Atom(1), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "feed.json"), JSONFeed(1), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a feed"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data.json"), []byte(`{"version": 1}`), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "rdf"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rdf", "feed.rdf"),
+		[]byte(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"></rdf:RDF>`), 0o600))
+
+	samples, err := Load(dir)
+	require.NoError(t, err)
+
+	got := make(map[string]types.SourceType, len(samples))
+	for sample := range slices.Values(samples) {
+		got[sample.Name] = sample.Format
+	}
+	assert.Equal(t, map[string]types.SourceType{
+		"feed.rss":     types.SourceTypeRSS,
+		"feed.atom":    types.SourceTypeAtom,
+		"feed.json":    types.SourceTypeJSONFeed,
+		"rdf/feed.rdf": types.SourceTypeRDF,
+	}, got)
+}
+
+func TestLoadMissing(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
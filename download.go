@@ -0,0 +1,168 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/extensions/media"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+var (
+	// ErrEnclosureDownload indicates an enclosure could not be downloaded.
+	ErrEnclosureDownload = errors.New("enclosure download failed")
+	// ErrChecksumMismatch indicates a downloaded enclosure did not match its checksum.
+	ErrChecksumMismatch = errors.New("enclosure checksum mismatch")
+)
+
+// DownloadOption is a functional option applied when downloading an enclosure with Enclosure.Download.
+type DownloadOption func(*downloadConfig)
+
+// downloadConfig holds the configuration of a download.
+type downloadConfig struct {
+	progress func(written, total int64)
+	offset   int64
+}
+
+// WithDownloadOffset option resumes a download from the given byte offset, for example, after an earlier download was
+// interrupted. The writer should already hold that many bytes of the enclosure; the remainder is requested with a range
+// request and written after them. If the server does not support range requests, the whole file is downloaded and the
+// first offset bytes are discarded.
+func WithDownloadOffset(offset int64) DownloadOption {
+	return func(c *downloadConfig) {
+		c.offset = max(offset, 0)
+	}
+}
+
+// WithDownloadProgress option sets a function that is called as the download progresses, with the number of bytes of
+// the enclosure written so far (including any offset) and its total size, or -1 if the size is not known.
+func WithDownloadProgress(progress func(written, total int64)) DownloadOption {
+	return func(c *downloadConfig) {
+		c.progress = progress
+	}
+}
+
+// Download downloads the enclosure, writing it to the given writer. A download can be resumed with the
+// WithDownloadOffset option and its progress reported with the WithDownloadProgress option.
+//
+// If the enclosure has any checksums (see Enclosure.Hashes), the download is verified against them, and an
+// ErrChecksumMismatch error is returned if it does not match. When resuming, the data already written is needed to
+// verify the checksums: it is read back from the writer if it is an io.ReaderAt (such as an *os.File), otherwise the
+// checksums are not verified.
+//
+// The given client is used to download the enclosure. If it is nil, a default client is used.
+func (e *Enclosure) Download(ctx context.Context, client *resty.Client, w io.Writer, options ...DownloadOption) error {
+	cfg := &downloadConfig{}
+	for option := range slices.Values(options) {
+		option(cfg)
+	}
+	if client == nil {
+		client = types.NewClient()
+	}
+
+	req := client.R().SetContext(ctx).SetDoNotParseResponse(true)
+	if cfg.offset > 0 {
+		req.SetHeader("Range", "bytes="+strconv.FormatInt(cfg.offset, 10)+"-")
+	}
+	resp, err := req.Get(e.URL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrEnclosureDownload, err)
+	}
+	defer resp.RawBody().Close()
+	if resp.IsError() {
+		return fmt.Errorf("%w: %s", ErrEnclosureDownload, resp.Status())
+	}
+
+	hashes := e.newHashes()
+	body := io.Reader(resp.RawBody())
+	total := resp.RawResponse.ContentLength
+	if cfg.offset > 0 && resp.StatusCode() == http.StatusPartialContent {
+		if total >= 0 {
+			total += cfg.offset
+		}
+		if hashes != nil {
+			// Read back the data already written, to include it in the checksums.
+			readerAt, ok := w.(io.ReaderAt)
+			if !ok {
+				hashes = nil
+			} else if _, err := io.Copy(hashWriter(hashes), io.NewSectionReader(readerAt, 0, cfg.offset)); err != nil {
+				return fmt.Errorf("%w: read existing data: %w", ErrEnclosureDownload, err)
+			}
+		}
+	} else if cfg.offset > 0 {
+		// The server sent the whole file, so skip the data already written.
+		if _, err := io.CopyN(hashWriter(hashes), body, cfg.offset); err != nil {
+			return fmt.Errorf("%w: skip existing data: %w", ErrEnclosureDownload, err)
+		}
+	}
+
+	out := io.MultiWriter(w, hashWriter(hashes))
+	if cfg.progress != nil {
+		out = &progressWriter{writer: out, written: cfg.offset, total: total, progress: cfg.progress}
+	}
+	if _, err := io.Copy(out, body); err != nil {
+		return fmt.Errorf("%w: %w", ErrEnclosureDownload, err)
+	}
+
+	for algo := range slices.Values(slices.Sorted(maps.Keys(hashes))) {
+		if got := hex.EncodeToString(hashes[algo].Sum(nil)); !strings.EqualFold(got, e.Hashes[algo]) {
+			return fmt.Errorf("%w: %s is %s, expected %s", ErrChecksumMismatch, algo, got, e.Hashes[algo])
+		}
+	}
+	return nil
+}
+
+// newHashes returns a hash for each checksum of the enclosure that can be verified, or nil if there are none.
+func (e *Enclosure) newHashes() map[string]hash.Hash {
+	var hashes map[string]hash.Hash
+	for algo, value := range e.Hashes {
+		hasher := media.MediaHashAlgo(algo).New()
+		if hasher == nil || value == "" {
+			continue
+		}
+		if hashes == nil {
+			hashes = make(map[string]hash.Hash)
+		}
+		hashes[algo] = hasher
+	}
+	return hashes
+}
+
+// hashWriter returns a writer that writes to all the given hashes.
+func hashWriter(hashes map[string]hash.Hash) io.Writer {
+	writers := make([]io.Writer, 0, len(hashes))
+	for h := range maps.Values(hashes) {
+		writers = append(writers, h)
+	}
+	return io.MultiWriter(writers...)
+}
+
+// progressWriter is a writer that reports the progress of a download as data is written.
+type progressWriter struct {
+	writer   io.Writer
+	progress func(written, total int64)
+	written  int64
+	total    int64
+}
+
+// Write satisfies the io.Writer interface.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	w.progress(w.written, w.total)
+	return n, err
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnclosureDownload(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	md5Sum := md5.Sum(data)
+	sha1Sum := sha1.Sum(data)
+	hashes := map[string]string{"md5": hex.EncodeToString(md5Sum[:]), "sha-1": hex.EncodeToString(sha1Sum[:])}
+
+	mux := http.NewServeMux()
+	// ServeContent supports range requests.
+	mux.HandleFunc("/episode.mp3", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "episode.mp3", time.Time{}, bytes.NewReader(data))
+	})
+	mux.HandleFunc("/norange.mp3", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(data)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("full", func(t *testing.T) {
+		var written, total int64
+		enclosure := &Enclosure{URL: server.URL + "/episode.mp3", Hashes: hashes}
+		var out bytes.Buffer
+		require.NoError(t, enclosure.Download(t.Context(), nil, &out, WithDownloadProgress(func(n, size int64) {
+			written, total = n, size
+		})))
+		assert.Equal(t, data, out.Bytes())
+		assert.Equal(t, int64(len(data)), written)
+		assert.Equal(t, int64(len(data)), total)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		enclosure := &Enclosure{URL: server.URL + "/episode.mp3", Hashes: map[string]string{"md5": "00"}}
+		err := enclosure.Download(t.Context(), nil, &bytes.Buffer{})
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		file, err := os.Create(filepath.Join(t.TempDir(), "episode.mp3"))
+		require.NoError(t, err)
+		defer file.Close()
+		_, err = file.Write(data[:4000])
+		require.NoError(t, err)
+
+		var written, total int64
+		enclosure := &Enclosure{URL: server.URL + "/episode.mp3", Hashes: hashes}
+		require.NoError(t, enclosure.Download(t.Context(), nil, file, WithDownloadOffset(4000),
+			WithDownloadProgress(func(n, size int64) {
+				written, total = n, size
+			})))
+		got, err := os.ReadFile(file.Name())
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+		assert.Equal(t, int64(len(data)), written)
+		assert.Equal(t, int64(len(data)), total)
+	})
+
+	t.Run("resume without range support", func(t *testing.T) {
+		out := bytes.NewBuffer(bytes.Clone(data[:4000]))
+		enclosure := &Enclosure{URL: server.URL + "/norange.mp3", Hashes: hashes}
+		require.NoError(t, enclosure.Download(t.Context(), nil, out, WithDownloadOffset(4000)))
+		assert.Equal(t, data, out.Bytes())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		enclosure := &Enclosure{URL: server.URL + "/missing.mp3"}
+		require.ErrorIs(t, enclosure.Download(t.Context(), nil, &bytes.Buffer{}), ErrEnclosureDownload)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/extensions/media"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// Enclosure is a media file attached to an Item, such as the audio of a podcast episode.
+type Enclosure struct {
+	// URL is the URL of the media file.
+	URL string `json:"url"`
+	// MimeType is the mimetype of the media file, if known.
+	MimeType string `json:"mime_type,omitempty"`
+	// Length is the size of the media file in bytes, if known.
+	Length int64 `json:"length,omitempty"`
+	// Duration is how long the media file takes to play, if known.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Title is a name for the media file, if any.
+	Title string `json:"title,omitempty"`
+	// Hashes are the checksums of the media file, if known, keyed by algorithm (md5 or sha-1). They are used to verify
+	// the file by Download.
+	Hashes map[string]string `json:"hashes,omitempty"`
+}
+
+// GetEnclosures returns the media files attached to the Item, regardless of its format:
+//
+//   - RSS: each <enclosure>, with the duration and <media:hash> checksums of any <media:content> for the same URL.
+//   - Atom: each link with an "enclosure" relation.
+//   - JSONFeed: each attachment, with its duration_in_seconds.
+func (i *Item) GetEnclosures() []Enclosure {
+	var enclosures []Enclosure
+	switch source := i.ItemSource.(type) {
+	case *rss.Item:
+		for rssEnclosure := range slices.Values(source.Enclosures) {
+			if strings.TrimSpace(rssEnclosure.URL) == "" {
+				continue
+			}
+			enclosure := Enclosure{
+				URL:      strings.TrimSpace(rssEnclosure.URL),
+				MimeType: rssEnclosure.Type,
+				Length:   int64(max(rssEnclosure.Length, 0)),
+			}
+			content := source.MediaContent
+			if content != nil && content.Duration != nil && content.URL == enclosure.URL {
+				enclosure.Duration = time.Duration(max(*content.Duration, 0)) * time.Second
+			}
+			enclosure.Hashes = mediaHashes(source, enclosure.URL)
+			enclosures = append(enclosures, enclosure)
+		}
+	case *atom.Entry:
+		for link := range slices.Values(source.Links) {
+			if link.Rel != atom.LinkRelEnclosure || strings.TrimSpace(link.Href) == "" {
+				continue
+			}
+			enclosure := Enclosure{
+				URL:      strings.TrimSpace(link.Href),
+				MimeType: derefString(link.Type),
+				Title:    derefString(link.Title),
+			}
+			if link.Length != nil {
+				enclosure.Length = int64(max(*link.Length, 0))
+			}
+			enclosures = append(enclosures, enclosure)
+		}
+	case *jsonfeed.Item:
+		for attachment := range slices.Values(source.Attachments) {
+			if strings.TrimSpace(attachment.URL) == "" {
+				continue
+			}
+			enclosure := Enclosure{
+				URL:      strings.TrimSpace(attachment.URL),
+				MimeType: derefString(attachment.MimeType),
+				Duration: attachment.GetDuration(),
+				Title:    derefString(attachment.Title),
+			}
+			if attachment.SizeInBytes != nil {
+				enclosure.Length = int64(max(*attachment.SizeInBytes, 0))
+			}
+			enclosures = append(enclosures, enclosure)
+		}
+	}
+	return enclosures
+}
+
+// mediaHashes returns the checksums of the <media:content> of the given item with the given URL, either on its own or
+// in a <media:group>, keyed by algorithm. A <media:hash> without an algorithm is an md5 checksum.
+func mediaHashes(item *rss.Item, url string) map[string]string {
+	var contents []media.MediaContent
+	if item.MediaContent != nil {
+		contents = append(contents, *item.MediaContent)
+	}
+	if item.MediaGroup != nil {
+		contents = append(contents, item.MediaGroup.Content...)
+	}
+	var hashes map[string]string
+	for content := range slices.Values(contents) {
+		if strings.TrimSpace(content.URL) != url {
+			continue
+		}
+		for hash := range slices.Values(content.MediaHashes) {
+			if hashes == nil {
+				hashes = make(map[string]string)
+			}
+			hashes[string(hash.GetAlgo())] = hash.GetValue()
+		}
+	}
+	return hashes
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/extensions/media"
+)
+
+func TestItemGetEnclosures(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want []Enclosure
+	}{
+		"rss": {
+			data: `<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/"><channel><title>Podcast</title>` +
+				`<item><title>Episode</title>` +
+				`<enclosure url="https://example.com/1.mp3" length="1024" type="audio/mpeg"/>` +
+				`<media:content url="https://example.com/1.mp3" duration="300"/></item></channel></rss>`,
+			want: []Enclosure{{
+				URL: "https://example.com/1.mp3", MimeType: "audio/mpeg", Length: 1024, Duration: 5 * time.Minute,
+			}},
+		},
+		"rss hashes": {
+			data: `<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/"><channel><title>Podcast</title>` +
+				`<item><title>Episode</title>` +
+				`<enclosure url="https://example.com/1.mp3" length="1024" type="audio/mpeg"/>` +
+				`<media:group><media:content url="https://example.com/1.mp3">` +
+				`<media:hash>D41D8CD98F00B204E9800998ECF8427E</media:hash>` +
+				`<media:hash algo="sha-1">da39a3ee5e6b4b0d3255bfef95601890afd80709</media:hash></media:content>` +
+				`<media:content url="https://example.com/1.ogg"><media:hash>ignored</media:hash></media:content>` +
+				`</media:group></item></channel></rss>`,
+			want: []Enclosure{{
+				URL: "https://example.com/1.mp3", MimeType: "audio/mpeg", Length: 1024,
+				Hashes: map[string]string{
+					"md5":   "d41d8cd98f00b204e9800998ecf8427e",
+					"sha-1": "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+				},
+			}},
+		},
+		"rss multiple": {
+			data: `<rss version="2.0"><channel><title>Podcast</title><item><title>Episode</title>` +
+				`<enclosure url="https://example.com/1.mp3" length="1024" type="audio/mpeg"/>` +
+				`<enclosure url="https://example.com/1.ogg" length="512" type="audio/ogg"/></item></channel></rss>`,
+			want: []Enclosure{
+				{URL: "https://example.com/1.mp3", MimeType: "audio/mpeg", Length: 1024},
+				{URL: "https://example.com/1.ogg", MimeType: "audio/ogg", Length: 512},
+			},
+		},
+		"atom": {
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Podcast</title><entry><title>Episode</title>` +
+				`<link rel="enclosure" href="https://example.com/1.mp3" type="audio/mpeg" length="2048" title="MP3"/>` +
+				`<link rel="alternate" href="https://example.com/1"/></entry></feed>`,
+			want: []Enclosure{{URL: "https://example.com/1.mp3", MimeType: "audio/mpeg", Length: 2048, Title: "MP3"}},
+		},
+		"jsonfeed": {
+			data: `{"version":"https://jsonfeed.org/version/1.1","title":"Podcast","items":[{"id":"1",` +
+				`"attachments":[{"url":"https://example.com/1.mp3","mime_type":"audio/mpeg","size_in_bytes":4096,` +
+				`"duration_in_seconds":1800,"title":"MP3"},{"url":"https://example.com/1.m4a","mime_type":"audio/mp4"}]}]}`,
+			want: []Enclosure{
+				{
+					URL: "https://example.com/1.mp3", MimeType: "audio/mpeg", Length: 4096, Duration: 30 * time.Minute,
+					Title: "MP3",
+				},
+				{URL: "https://example.com/1.m4a", MimeType: "audio/mp4"},
+			},
+		},
+		"none": {
+			data: `<rss version="2.0"><channel><title>Blog</title><item><title>Post</title></item></channel></rss>`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			items := feed.GetItems()
+			require.Len(t, items, 1)
+			assert.Equal(t, tt.want, items[0].GetEnclosures())
+			assert.Equal(t, len(tt.want) > 0, HasEnclosure(items[0]))
+		})
+	}
+}
+
+func TestMediaAccessors(t *testing.T) {
+	data := []byte("episode")
+	md5Sum := md5.Sum(data)
+	elements := `<media:credit role="host">Host</media:credit><media:text>Transcript</media:text>` +
+		`<media:group><media:credit role="Host">Host</media:credit><media:credit role="guest">Guest</media:credit>` +
+		`<media:content url="https://example.com/1.mp3"><media:hash algo="SHA-1">0000</media:hash>` +
+		`<media:hash>` + hex.EncodeToString(md5Sum[:]) + `</media:hash><media:text>Lyrics</media:text>` +
+		`</media:content></media:group>`
+	rssData := `<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/"><channel><title>Podcast</title>` +
+		`<item><title>Episode</title>` + elements + `</item></channel></rss>`
+	atomData := `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">` +
+		`<title>Podcast</title><entry><title>Episode</title>` + elements + `</entry></feed>`
+
+	type mediaSource interface {
+		GetMediaCredits() media.MediaCredits
+		GetMediaHashes() media.MediaHashes
+		GetMediaTexts() media.MediaTexts
+		VerifyEnclosureHash(data []byte) error
+	}
+	for name, data := range map[string]string{"rss": rssData, "atom": atomData} {
+		t.Run(name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(data))
+			require.NoError(t, err)
+			items := feed.GetItems()
+			require.Len(t, items, 1)
+			source, ok := items[0].ItemSource.(mediaSource)
+			require.True(t, ok)
+
+			credits := source.GetMediaCredits()
+			require.Len(t, credits, 2)
+			assert.Equal(t, "host", credits[0].GetRole())
+			assert.Equal(t, "Guest", credits[1].Value)
+			assert.Len(t, source.GetMediaHashes(), 2)
+			texts := source.GetMediaTexts()
+			require.Len(t, texts, 2)
+			assert.Equal(t, "Lyrics", texts[1].Value)
+
+			require.NoError(t, source.VerifyEnclosureHash([]byte("episode")))
+			require.ErrorIs(t, source.VerifyEnclosureHash([]byte("other")), media.ErrHashMismatch)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEnricherWorkers is the default number of items enriched concurrently.
+	DefaultEnricherWorkers = 8
+	// DefaultEnricherHostLimit is the default number of items from the same host enriched concurrently.
+	DefaultEnricherHostLimit = 2
+)
+
+// ErrEnrich indicates an error occurred trying to enrich an item.
+var ErrEnrich = errors.New("unable to enrich item")
+
+// EnrichFunc enriches a single item, for example, by discovering an image, fetching OpenGraph metadata or fetching the
+// full content of the item. It should respect the cancellation of the given context.
+type EnrichFunc func(ctx context.Context, item *Item) error
+
+// EnrichResult is the result of enriching a single item. If any enrichment failed, Err will be non-nil, but the Item
+// will still contain the results of any enrichment that succeeded.
+type EnrichResult struct {
+	Item *Item
+	Err  error
+}
+
+// Enricher runs a set of EnrichFunc over items concurrently. The number of items enriched at once, both overall and
+// per host, is bounded, so that enriching a large feed neither serializes every request nor overwhelms a single site.
+type Enricher struct {
+	hosts     map[string]chan struct{}
+	enrichers []EnrichFunc
+	workers   int
+	hostLimit int
+	timeout   time.Duration
+	mu        sync.Mutex
+}
+
+// EnricherOption is a functional option applied to an Enricher.
+type EnricherOption func(*Enricher)
+
+// WithEnrichment option adds the given EnrichFunc to the Enricher. Functions are run on each item in the order they were
+// added.
+func WithEnrichment(enricher EnrichFunc) EnricherOption {
+	return func(e *Enricher) {
+		e.enrichers = append(e.enrichers, enricher)
+	}
+}
+
+// WithWorkers option sets the number of items that are enriched concurrently.
+func WithWorkers(workers int) EnricherOption {
+	return func(e *Enricher) {
+		if workers > 0 {
+			e.workers = workers
+		}
+	}
+}
+
+// WithHostLimit option sets the number of items from the same host (as determined by the item link) that are enriched
+// concurrently.
+func WithHostLimit(limit int) EnricherOption {
+	return func(e *Enricher) {
+		if limit > 0 {
+			e.hostLimit = limit
+		}
+	}
+}
+
+// WithItemTimeout option sets a deadline for enriching each item. An item that exceeds the deadline is returned with
+// the results of any enrichment that completed and a context.DeadlineExceeded error.
+func WithItemTimeout(timeout time.Duration) EnricherOption {
+	return func(e *Enricher) {
+		e.timeout = timeout
+	}
+}
+
+// NewEnricher creates a new Enricher with the given options.
+func NewEnricher(options ...EnricherOption) *Enricher {
+	enricher := &Enricher{
+		hosts:     make(map[string]chan struct{}),
+		workers:   DefaultEnricherWorkers,
+		hostLimit: DefaultEnricherHostLimit,
+	}
+	for option := range slices.Values(options) {
+		option(enricher)
+	}
+	return enricher
+}
+
+// Run enriches the items received on the given channel, sending a result for each on the returned channel. The returned
+// channel is unbuffered, so enrichment will only proceed as fast as the results are consumed. The returned channel is
+// closed once the input channel is closed and all items have been enriched, or the context is canceled.
+func (e *Enricher) Run(ctx context.Context, items <-chan *Item) <-chan EnrichResult {
+	results := make(chan EnrichResult)
+
+	var wg sync.WaitGroup
+	for range e.workers {
+		wg.Go(func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case item, ok := <-items:
+					if !ok {
+						return
+					}
+					result := EnrichResult{Item: item, Err: e.enrich(ctx, item)}
+					select {
+					case <-ctx.Done():
+						return
+					case results <- result:
+					}
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// Enrich enriches the given items, returning a result for each in the same order as the items. Items that could not be
+// enriched before the context was canceled will have the context error as their result error.
+func (e *Enricher) Enrich(ctx context.Context, items []Item) []EnrichResult {
+	results := make([]EnrichResult, len(items))
+	for idx := range items {
+		results[idx].Item = &items[idx]
+	}
+
+	input := make(chan *Item)
+	go func() {
+		defer close(input)
+		for idx := range items {
+			select {
+			case <-ctx.Done():
+				return
+			case input <- &items[idx]:
+			}
+		}
+	}()
+
+	index := make(map[*Item]int, len(items))
+	for idx := range items {
+		index[&items[idx]] = idx
+	}
+	completed := make([]bool, len(items))
+	for result := range e.Run(ctx, input) {
+		results[index[result.Item]] = result
+		completed[index[result.Item]] = true
+	}
+	for idx := range completed {
+		if !completed[idx] {
+			results[idx].Err = fmt.Errorf("%w: %w", ErrEnrich, ctx.Err())
+		}
+	}
+
+	return results
+}
+
+// enrich runs each EnrichFunc over the given item, returning any errors.
+func (e *Enricher) enrich(ctx context.Context, item *Item) error {
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
+	release, err := e.acquireHost(ctx, item)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrEnrich, err)
+	}
+	defer release()
+
+	var errs []error
+	for enricher := range slices.Values(e.enrichers) {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := enricher(ctx, item); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrEnrich, errors.Join(errs...))
+	}
+	return nil
+}
+
+// acquireHost waits until the item can be enriched within the host limit. The returned function must be called once
+// enrichment is complete.
+func (e *Enricher) acquireHost(ctx context.Context, item *Item) (func(), error) {
+	var host string
+	if link, err := url.Parse(item.GetLink()); err == nil {
+		host = link.Hostname()
+	}
+
+	e.mu.Lock()
+	slots, found := e.hosts[host]
+	if !found {
+		slots = make(chan struct{}, e.hostLimit)
+		e.hosts[host] = slots
+	}
+	e.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	}
+}
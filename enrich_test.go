@@ -0,0 +1,117 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func newEnrichTestItems(count int) []Item {
+	items := make([]Item, 0, count)
+	for idx := range count {
+		items = append(items, Item{
+			ItemSource: rss.NewItem(
+				rss.WithItemTitle(fmt.Sprintf("Item %d", idx)),
+				rss.WithItemLink(fmt.Sprintf("https://host%d.example.com/%d", idx%2, idx)),
+			),
+		})
+	}
+	return items
+}
+
+func TestEnricherLimits(t *testing.T) {
+	var (
+		active, maxActive atomic.Int32
+		hostActive        sync.Map
+		maxHostActive     atomic.Int32
+	)
+	enrichFn := func(_ context.Context, item *Item) error {
+		link, err := url.Parse(item.GetLink())
+		require.NoError(t, err)
+		counter, _ := hostActive.LoadOrStore(link.Host, &atomic.Int32{})
+		hostCount := counter.(*atomic.Int32).Add(1)
+		defer counter.(*atomic.Int32).Add(-1)
+		current := active.Add(1)
+		defer active.Add(-1)
+
+		for {
+			if previous := maxActive.Load(); current <= previous || maxActive.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		for {
+			if previous := maxHostActive.Load(); hostCount <= previous || maxHostActive.CompareAndSwap(previous, hostCount) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		item.ItemSource.(*rss.Item).Description = rss.NewItemDescription("enriched", false)
+		return nil
+	}
+
+	items := newEnrichTestItems(20)
+	enricher := NewEnricher(WithEnrichment(enrichFn), WithWorkers(4), WithHostLimit(1))
+	results := enricher.Enrich(t.Context(), items)
+	require.Len(t, results, 20)
+	for idx, result := range results {
+		require.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("Item %d", idx), result.Item.GetTitle())
+		assert.Equal(t, "enriched", items[idx].GetDescription())
+	}
+	assert.LessOrEqual(t, maxActive.Load(), int32(2))
+	assert.Equal(t, int32(1), maxHostActive.Load())
+}
+
+func TestEnricherPartialResults(t *testing.T) {
+	errFailed := errors.New("failed")
+	enricher := NewEnricher(
+		WithEnrichment(func(_ context.Context, item *Item) error {
+			item.ItemSource.(*rss.Item).Description = rss.NewItemDescription("enriched", false)
+			return nil
+		}),
+		WithEnrichment(func(ctx context.Context, item *Item) error {
+			switch item.GetTitle() {
+			case "Item 0":
+				return errFailed
+			case "Item 1":
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		}),
+		WithItemTimeout(10*time.Millisecond),
+	)
+
+	results := enricher.Enrich(t.Context(), newEnrichTestItems(3))
+	require.Len(t, results, 3)
+	require.ErrorIs(t, results[0].Err, ErrEnrich)
+	require.ErrorIs(t, results[0].Err, errFailed)
+	require.ErrorIs(t, results[1].Err, context.DeadlineExceeded)
+	require.NoError(t, results[2].Err)
+	for result := range results {
+		assert.Equal(t, "enriched", results[result].Item.GetDescription())
+	}
+}
+
+func TestEnricherCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	results := NewEnricher().Enrich(ctx, newEnrichTestItems(5))
+	require.Len(t, results, 5)
+	for _, result := range results {
+		require.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
@@ -6,16 +6,18 @@ package extensions
 // WellKnownNamespaces is a convenience registry of namespace URIs commonly seen in RSS feeds. It's just a lookup table
 // that can be used to lookup commonly used namespaces. It does not reflect all known namespaces and can be overridden.
 var WellKnownNamespaces = map[string]string{
-	"rdf":     "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
-	"content": "http://purl.org/rss/1.0/modules/content/",
-	"media":   "http://search.yahoo.com/mrss/",
-	"atom":    "http://www.w3.org/2005/Atom",
-	"dc":      "http://purl.org/dc/elements/1.1/",
-	"slash":   "http://purl.org/rss/1.0/modules/slash/",
-	"syn":     "http://purl.org/rss/1.0/modules/syndication/",
-	"itunes":  "http://www.itunes.com/dtds/podcast-1.0.dtd",
-	"georss":  "http://www.georss.org/georss",
-	"wfw":     "http://wellformedweb.org/CommentAPI/",
+	"rdf":        "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+	"content":    "http://purl.org/rss/1.0/modules/content/",
+	"media":      "http://search.yahoo.com/mrss/",
+	"atom":       "http://www.w3.org/2005/Atom",
+	"dc":         "http://purl.org/dc/elements/1.1/",
+	"slash":      "http://purl.org/rss/1.0/modules/slash/",
+	"syn":        "http://purl.org/rss/1.0/modules/syndication/",
+	"itunes":     "http://www.itunes.com/dtds/podcast-1.0.dtd",
+	"georss":     "http://www.georss.org/georss",
+	"wfw":        "http://wellformedweb.org/CommentAPI/",
+	"feedburner": "http://rssnamespace.org/feedburner/ext/1.0",
+	"podcast":    "https://podcastindex.org/namespace/1.0",
 }
 
 // NewNamespace builds a Namespace. NewNamespace("content") looks up the canonical URI from the well-known registry
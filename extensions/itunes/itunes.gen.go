@@ -24,6 +24,9 @@ func (e Type) Valid() bool {
 // Author is the author of the show content.
 type Author = string
 
+// Block indicates whether the show should be hidden from podcast directories.
+type Block = Flag
+
 // Categories is the set of all taxonomies that represent the show.
 type Categories struct {
 	Categories []Category `json:"itunes_category" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category,omitempty"`
@@ -38,6 +41,12 @@ type Category struct {
 	Text string `json:"text" xml:"text,attr"`
 }
 
+// Complete indicates whether the show is complete and no more episodes will be published.
+type Complete = Flag
+
+// Email defines model for Email.
+type Email = string
+
 // Explicit indicates whether the content is explicit in nature.
 type Explicit = bool
 
@@ -52,14 +61,25 @@ type ItunesElements struct {
 	// ItunesAuthor is the author of the show content.
 	ItunesAuthor Author `json:"itunes_author" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd author,omitempty"`
 
+	// ItunesBlock indicates whether the show should be hidden from podcast directories.
+	ItunesBlock Block `json:"itunes_block" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd block,omitempty"`
+
 	// ItunesCategory is the set of all taxonomies that represent the show.
 	ItunesCategory Categories `json:"itunes_category" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category,omitempty"`
 
+	// ItunesComplete indicates whether the show is complete and no more episodes will be published.
+	ItunesComplete Complete `json:"itunes_complete" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd complete,omitempty"`
+
 	// ItunesExplicit indicates whether the content is explicit in nature.
 	ItunesExplicit Explicit `json:"itunes_explicit" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit,omitempty"`
 
 	// ItunesImage is the artwork for the show.
 	ItunesImage Image `json:"itunes_image" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image,omitempty"`
+
+	// ItunesNewFeedURL is the URL the show has moved to, which should be used instead of the URL of this feed.
+	ItunesNewFeedURL NewFeedURL `json:"itunes_new_feed_url" validate:"omitempty,url" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd new-feed-url,omitempty"`
+
+	// ItunesOwner is the contact information of the owner of the show, for use by podcast directories only.
 	ItunesOwner Owner `json:"itunes_owner" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd owner,omitempty"`
 
 	// ItunesSubtitle is a subtitle for the show content.
@@ -75,9 +95,13 @@ type ItunesElements struct {
 // Name defines model for Name.
 type Name = string
 
-// Owner defines model for Owner.
+// NewFeedURL is the URL the show has moved to, which should be used instead of the URL of this feed.
+type NewFeedURL = string
+
+// Owner is the contact information of the owner of the show, for use by podcast directories only.
 type Owner struct {
-	Name Name `json:"itunes_name" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd name,omitempty"`
+	Email Email `json:"itunes_email" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd email,omitempty"`
+	Name  Name  `json:"itunes_name" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd name,omitempty"`
 }
 
 // Subtitle is a subtitle for the show content.
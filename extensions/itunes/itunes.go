@@ -4,11 +4,38 @@
 package itunes
 
 import (
+	"encoding/xml"
+	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/immanent-tech/go-syndication/sanitization"
 )
 
+// Flag is the value of an iTunes element that is set with "Yes", such as <itunes:block> or <itunes:complete>. Any other
+// value leaves the flag unset.
+type Flag bool
+
+// MarshalXML encodes the Flag as "Yes" or "No".
+func (f Flag) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	value := "No"
+	if f {
+		value = "Yes"
+	}
+	return enc.EncodeElement(value, start)
+}
+
+// UnmarshalXML decodes the Flag, which is set if the value is "Yes" (or "true"), ignoring case.
+func (f *Flag) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := dec.DecodeElement(&value, &start); err != nil {
+		return fmt.Errorf("decode itunes flag: %w", err)
+	}
+	value = strings.TrimSpace(value)
+	*f = Flag(strings.EqualFold(value, "yes") || strings.EqualFold(value, "true"))
+	return nil
+}
+
 func (c Category) String() string {
 	return sanitization.SanitizeString(c.Text)
 }
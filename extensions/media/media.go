@@ -5,9 +5,13 @@
 package media
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"hash"
 	"net/url"
 	"slices"
 	"strconv"
@@ -17,6 +21,13 @@ import (
 	"github.com/immanent-tech/go-syndication/types"
 )
 
+var (
+	// ErrHashMismatch indicates data did not match any of the <media:hash> checksums of a media object.
+	ErrHashMismatch = errors.New("media:hash mismatch")
+	// ErrNoHash indicates a media object has no <media:hash> checksums that can be verified.
+	ErrNoHash = errors.New("no verifiable media:hash")
+)
+
 // AsImage returns the <media:thumbnail> object as a types.ImageInfo object.
 func (t *MediaThumbnail) AsImage() *types.ImageInfo {
 	return &types.ImageInfo{
@@ -126,13 +137,16 @@ func (r MediaRestriction) Validate() error {
 	if v == "all" || v == "none" {
 		return nil // type may legitimately be omitted for these reserved literals
 	}
+	if r.Type == nil {
+		return errors.New("media:restriction: type is required unless value is \"all\"/\"none\"")
+	}
 	switch *r.Type {
 	case "country", "uri", "sharing":
 		return nil
 	default:
 		return fmt.Errorf(
 			"media:restriction: type must be \"country\", \"uri\", or \"sharing\" unless value is \"all\"/\"none\", got %q",
-			r.Type,
+			*r.Type,
 		)
 	}
 }
@@ -185,3 +199,192 @@ func (t *MediaTags) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error
 	}
 	return nil
 }
+
+// New returns a new hash.Hash computing checksums with the algorithm, or nil if the algorithm is not supported.
+func (e MediaHashAlgo) New() hash.Hash {
+	switch e {
+	case Md5:
+		return md5.New()
+	case Sha1:
+		return sha1.New()
+	default:
+		return nil
+	}
+}
+
+// GetAlgo returns the algorithm of the <media:hash>, in lower-case. A <media:hash> without an algorithm is an md5
+// checksum.
+func (h *MediaHash) GetAlgo() MediaHashAlgo {
+	if h.Algo == nil || strings.TrimSpace(string(*h.Algo)) == "" {
+		return Md5
+	}
+	return MediaHashAlgo(strings.ToLower(strings.TrimSpace(string(*h.Algo))))
+}
+
+// GetValue returns the checksum of the <media:hash>, as lower-case hex.
+func (h *MediaHash) GetValue() string {
+	return strings.ToLower(strings.TrimSpace(h.Value))
+}
+
+// Verify checks the given data against the checksums. It returns nil if the data matches any of them, an
+// ErrHashMismatch error if it matches none of them, or an ErrNoHash error if none of them use a supported algorithm.
+func (h MediaHashes) Verify(data []byte) error {
+	sums := make(map[MediaHashAlgo]string)
+	for mediaHash := range slices.Values(h) {
+		algo := mediaHash.GetAlgo()
+		if _, ok := sums[algo]; !ok {
+			hasher := algo.New()
+			if hasher == nil {
+				continue
+			}
+			hasher.Write(data)
+			sums[algo] = hex.EncodeToString(hasher.Sum(nil))
+		}
+		if sums[algo] == mediaHash.GetValue() {
+			return nil
+		}
+	}
+	if len(sums) == 0 {
+		return ErrNoHash
+	}
+	return ErrHashMismatch
+}
+
+// CollectCredits returns the <media:credit> elements at every level of a media object: those of the item (or entry)
+// itself, then those of its <media:group>, then those of each <media:content>. Credits repeated at several levels are
+// only returned once.
+func CollectCredits(credits MediaCredits, group *MediaGroup, contents ...MediaContent) MediaCredits {
+	levels := []MediaCredits{credits}
+	if group != nil {
+		levels = append(levels, group.MediaCredits)
+	}
+	for content := range slices.Values(allContents(group, contents)) {
+		levels = append(levels, content.MediaCredits)
+	}
+	var all MediaCredits
+	for credit := range slices.Values(slices.Concat(levels...)) {
+		if !slices.ContainsFunc(all, func(existing MediaCredit) bool {
+			return existing.Value == credit.Value && existing.GetRole() == credit.GetRole()
+		}) {
+			all = append(all, credit)
+		}
+	}
+	return all
+}
+
+// CollectHashes returns the <media:hash> elements at every level of a media object: those of the item (or entry)
+// itself, then those of its <media:group>, then those of each <media:content>. Hashes repeated at several levels are
+// only returned once.
+func CollectHashes(hashes MediaHashes, group *MediaGroup, contents ...MediaContent) MediaHashes {
+	levels := []MediaHashes{hashes}
+	if group != nil {
+		levels = append(levels, group.MediaHashes)
+	}
+	for content := range slices.Values(allContents(group, contents)) {
+		levels = append(levels, content.MediaHashes)
+	}
+	var all MediaHashes
+	for mediaHash := range slices.Values(slices.Concat(levels...)) {
+		if !slices.ContainsFunc(all, func(existing MediaHash) bool {
+			return existing.GetAlgo() == mediaHash.GetAlgo() && existing.GetValue() == mediaHash.GetValue()
+		}) {
+			all = append(all, mediaHash)
+		}
+	}
+	return all
+}
+
+// CollectTexts returns the <media:text> elements at every level of a media object: those of the item (or entry)
+// itself, then those of its <media:group>, then those of each <media:content>.
+func CollectTexts(texts MediaTexts, group *MediaGroup, contents ...MediaContent) MediaTexts {
+	levels := []MediaTexts{texts}
+	if group != nil {
+		levels = append(levels, group.MediaTexts)
+	}
+	for content := range slices.Values(allContents(group, contents)) {
+		levels = append(levels, content.MediaTexts)
+	}
+	return slices.Concat(levels...)
+}
+
+// CollectCommunity returns the <media:community> of a media object, merged from every level: the star rating and
+// statistics are those of the first level that has them, checking the item (or entry) itself, then its <media:group>,
+// then each <media:content>, while the tags of every level are combined. If no level has a <media:community>, nil is
+// returned.
+func CollectCommunity(community *MediaCommunity, group *MediaGroup, contents ...MediaContent) *MediaCommunity {
+	levels := []*MediaCommunity{community}
+	if group != nil {
+		levels = append(levels, group.MediaCommunity)
+	}
+	for content := range slices.Values(allContents(group, contents)) {
+		levels = append(levels, content.MediaCommunity)
+	}
+	var merged *MediaCommunity
+	for level := range slices.Values(levels) {
+		if level == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &MediaCommunity{}
+		}
+		if merged.StarRating == nil {
+			merged.StarRating = level.StarRating
+		}
+		if merged.Statistics == nil {
+			merged.Statistics = level.Statistics
+		}
+		merged.Tags = append(merged.Tags, level.Tags...)
+	}
+	return merged
+}
+
+// GetStarRating returns the <media:starRating> of the <media:community> (if any).
+func (c *MediaCommunity) GetStarRating() *MediaStarRating {
+	if c == nil {
+		return nil
+	}
+	return c.StarRating
+}
+
+// GetStatistics returns the <media:statistics> of the <media:community> (if any).
+func (c *MediaCommunity) GetStatistics() *MediaStatistics {
+	if c == nil {
+		return nil
+	}
+	return c.Statistics
+}
+
+// GetViews returns the view count from the <media:statistics> of the <media:community>, or 0 if there are no
+// statistics.
+func (c *MediaCommunity) GetViews() int {
+	if c == nil || c.Statistics == nil {
+		return 0
+	}
+	return c.Statistics.Views
+}
+
+// GetFavorites returns the favorite count from the <media:statistics> of the <media:community>, or 0 if there are no
+// statistics.
+func (c *MediaCommunity) GetFavorites() int {
+	if c == nil || c.Statistics == nil {
+		return 0
+	}
+	return c.Statistics.Favorites
+}
+
+// GetRole returns the role of the <media:credit>, in lower-case.
+func (c *MediaCredit) GetRole() string {
+	if c.Role == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(*c.Role))
+}
+
+// allContents returns the <media:content> elements of the given <media:group> (if any), followed by the given
+// <media:content> elements.
+func allContents(group *MediaGroup, contents []MediaContent) []MediaContent {
+	if group == nil {
+		return contents
+	}
+	return slices.Concat(group.Content, contents)
+}
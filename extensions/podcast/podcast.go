@@ -0,0 +1,197 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package podcast
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"mime"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TranscriptTypeJSON is the MIME type of a transcript in the JSON transcript format.
+	TranscriptTypeJSON = "application/json"
+	// TranscriptTypeSRT is the MIME type of a transcript in the SRT (SubRip) format.
+	TranscriptTypeSRT = "application/x-subrip"
+	// TranscriptTypeVTT is the MIME type of a transcript in the WebVTT format.
+	TranscriptTypeVTT = "text/vtt"
+
+	// byteOrderMark is the UTF-8 byte order mark that some transcript files start with.
+	byteOrderMark = "\ufeff"
+	// transcriptVersion is the version of the JSON transcript format that SRT and WebVTT transcripts are converted to.
+	transcriptVersion = "1.0.0"
+)
+
+var (
+	// ErrInvalidChapters indicates a chapters file could not be parsed.
+	ErrInvalidChapters = errors.New("invalid chapters")
+	// ErrInvalidTranscript indicates a transcript file could not be parsed.
+	ErrInvalidTranscript = errors.New("invalid transcript")
+	// ErrUnsupportedTranscript indicates a transcript file is in a format that cannot be parsed, such as HTML or plain
+	// text.
+	ErrUnsupportedTranscript = errors.New("unsupported transcript format")
+)
+
+var (
+	// cueTags matches the tags in the text of a cue, such as <i>, <c.yellow> or a <00:00:01.000> timestamp.
+	cueTags = regexp.MustCompile(`<[^>]*>`)
+	// cueVoice matches a WebVTT voice tag (e.g., <v Speaker> or <v.loud Speaker>), capturing the speaker.
+	cueVoice = regexp.MustCompile(`^<v(?:\.[^\s>]*)?\s+([^>]+)>`)
+)
+
+// GetStartTime returns the start time of the Chapter.
+func (c *Chapter) GetStartTime() time.Duration {
+	return seconds(c.StartTime)
+}
+
+// GetEndTime returns the end time of the Chapter, or zero if the chapters file does not specify it.
+func (c *Chapter) GetEndTime() time.Duration {
+	return seconds(c.EndTime)
+}
+
+// InTableOfContents reports whether the Chapter should be shown in a table of contents of the episode. Chapters are
+// shown unless they are marked otherwise, which is used for chapters that only change the image or link.
+func (c *Chapter) InTableOfContents() bool {
+	return c.Toc == nil || *c.Toc
+}
+
+// GetStartTime returns the start time of the TranscriptSegment.
+func (s *TranscriptSegment) GetStartTime() time.Duration {
+	return seconds(s.StartTime)
+}
+
+// GetEndTime returns the end time of the TranscriptSegment.
+func (s *TranscriptSegment) GetEndTime() time.Duration {
+	return seconds(s.EndTime)
+}
+
+// ParseChapters parses the given chapters file, in the JSON chapters format linked to by a <podcast:chapters>. The
+// chapters are sorted by their start time.
+func ParseChapters(data []byte) (*ChaptersDocument, error) {
+	var chapters ChaptersDocument
+	if err := json.Unmarshal(data, &chapters); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidChapters, err)
+	}
+	slices.SortStableFunc(chapters.Chapters, func(a, b Chapter) int {
+		return cmp.Compare(a.StartTime, b.StartTime)
+	})
+	return &chapters, nil
+}
+
+// ParseTranscript parses the given transcript file, as linked to by a <podcast:transcript>, with the given MIME type.
+// Transcripts in the JSON transcript, SRT and WebVTT formats are supported; SRT and WebVTT cues are converted to
+// segments, with the speaker taken from any WebVTT voice tag and any other tags removed. If the MIME type is empty or
+// not one of these formats, the format is detected from the data. An ErrUnsupportedTranscript error is returned for
+// other formats, such as HTML or plain text.
+func ParseTranscript(data []byte, mimeType string) (*TranscriptDocument, error) {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	switch mediaType {
+	case TranscriptTypeJSON:
+		return parseJSONTranscript(data)
+	case TranscriptTypeSRT, "application/srt", "text/srt", TranscriptTypeVTT:
+		return parseCues(data)
+	}
+
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte(byteOrderMark)))
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return parseJSONTranscript(data)
+	case bytes.HasPrefix(trimmed, []byte("WEBVTT")), bytes.Contains(trimmed, []byte("-->")):
+		return parseCues(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedTranscript, mimeType)
+	}
+}
+
+// parseJSONTranscript parses the given transcript file in the JSON transcript format.
+func parseJSONTranscript(data []byte) (*TranscriptDocument, error) {
+	var transcript TranscriptDocument
+	if err := json.Unmarshal(data, &transcript); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTranscript, err)
+	}
+	return &transcript, nil
+}
+
+// parseCues parses the given transcript file in the SRT or WebVTT format, both of which are a list of cues separated by
+// blank lines. Each cue has an optional identifier (a sequence number for SRT), a line with its start and end times and
+// then its text. WebVTT headers, comments and style blocks do not have times and are skipped.
+func parseCues(data []byte) (*TranscriptDocument, error) {
+	text := strings.ReplaceAll(strings.TrimPrefix(string(data), byteOrderMark), "\r\n", "\n")
+	transcript := &TranscriptDocument{Version: transcriptVersion, Segments: []TranscriptSegment{}}
+	for block := range strings.SplitSeq(text, "\n\n") {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		idx := slices.IndexFunc(lines, func(line string) bool {
+			return strings.Contains(line, "-->")
+		})
+		if idx < 0 || strings.HasPrefix(lines[0], "NOTE") {
+			continue
+		}
+		start, end, err := parseCueTimes(lines[idx])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidTranscript, err)
+		}
+		segment := TranscriptSegment{StartTime: start, EndTime: end}
+		body := strings.TrimSpace(strings.Join(lines[idx+1:], " "))
+		if voice := cueVoice.FindStringSubmatch(body); voice != nil {
+			segment.Speaker = strings.TrimSpace(voice[1])
+		}
+		segment.Body = strings.TrimSpace(html.UnescapeString(cueTags.ReplaceAllString(body, "")))
+		transcript.Segments = append(transcript.Segments, segment)
+	}
+	return transcript, nil
+}
+
+// parseCueTimes parses the timing line of a cue (e.g., "00:00:01,000 --> 00:00:04,000"), returning its start and end
+// times in seconds. Any WebVTT cue settings after the end time are ignored.
+func parseCueTimes(line string) (float64, float64, error) {
+	startValue, endValue, _ := strings.Cut(line, "-->")
+	endFields := strings.Fields(endValue)
+	if len(endFields) == 0 {
+		return 0, 0, fmt.Errorf("cue has no end time: %q", line)
+	}
+	start, err := parseTimestamp(strings.TrimSpace(startValue))
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseTimestamp(endFields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseTimestamp parses a cue timestamp, which is hours (optional for WebVTT), minutes and seconds separated by colons,
+// with the milliseconds separated by a comma (SRT) or period (WebVTT), returning it in seconds.
+func parseTimestamp(value string) (float64, error) {
+	parts := strings.Split(strings.Replace(value, ",", ".", 1), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp: %q", value)
+	}
+	var total float64
+	for part := range slices.Values(parts) {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("invalid timestamp: %q", value)
+		}
+		total = total*60 + number
+	}
+	return total, nil
+}
+
+// seconds converts the given number of seconds to a time.Duration.
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
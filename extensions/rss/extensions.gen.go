@@ -43,6 +43,9 @@ type ContentEncoded struct {
 	Value string `json:"value"`
 }
 
+// FeedBurnerOrigLink is the original link of an item in a feed proxied by FeedBurner, which replaces the link of the item with a link to its own redirect.
+type FeedBurnerOrigLink = string
+
 // PermaLink is defined as a URL for a resource that is always available (similar to a PURL). Some weblogs cycle through articles and a URL may become invalid after a period of time. Permalinks provide a link that is always available to and should be provided within RSS so that clients can use this instead of a temporary link.
 type PermaLink struct {
 	// Resource provides the URL for the target of this link. The URL given must be absolute, we do not support relative paths. This is due to the presence of caching software which might not be mod_link aware. If the URL given was relative it may break when the cached resource is given to a calling application. Note that mod_link aware caching engines are also encouraged to consider caching the content represented by the target resource.
@@ -72,3 +75,6 @@ type SyndicationElements struct {
 	// SYUpdateFrequency describes the frequency of updates in relation to the update period.
 	SYUpdateFrequency *SYUpdateFrequency `json:"update_frequency,omitempty" validate:"omitempty,number,gte=1" xml:"http://purl.org/rss/1.0/modules/syndication/ updateFrequency,omitempty"`
 }
+
+// WFWCommentRSS is the URL of a feed of the comments on an item, from the Well-Formed Web CommentAPI.
+type WFWCommentRSS = string
@@ -59,19 +59,36 @@ func (c *ContentEncoded) UnmarshalXML(dec *xml.Decoder, start xml.StartElement)
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The content is encoded as a plain string, unless it is written as CDATA, in
+// which case it is encoded as an object with the value and the cdata flag, so that it survives a round trip.
 func (c ContentEncoded) MarshalJSON() ([]byte, error) {
-	data, err := json.Marshal(c.Value)
+	var data []byte
+	var err error
+	if c.CDATA {
+		data, err = json.Marshal(contentEncodedJSON(c))
+	} else {
+		data, err = json.Marshal(c.Value)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("marshal content:encoded: %w", err)
 	}
 	return data, nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts either encoding written by MarshalJSON.
 func (c *ContentEncoded) UnmarshalJSON(data []byte) error {
 	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = ContentEncoded{Value: s}
+		return nil
+	}
+	var v contentEncodedJSON
+	if err := json.Unmarshal(data, &v); err != nil {
 		return fmt.Errorf("unmarshal content:encoded: %w", err)
 	}
-	c.Value = s
+	*c = ContentEncoded(v)
 	return nil
 }
+
+// contentEncodedJSON is ContentEncoded without its JSON methods.
+type contentEncodedJSON ContentEncoded
@@ -0,0 +1,122 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Package feedstate tracks the fetch state of a feed: the HTTP validators (ETag and Last-Modified) used to make
+// conditional requests, when the feed was last fetched successfully, and how many fetches in a row have failed, from
+// which a backoff before the next fetch is computed. A State is serializable to JSON, so that applications can persist
+// it between runs and inspect the health of their feeds.
+//
+// Pass a State to feeds.NewFeedFromURL or Feed.Refresh with the feeds.WithFetchState option to have it used and updated
+// by the fetch.
+package feedstate
+
+import (
+	"time"
+)
+
+const (
+	// DefaultBaseBackoff is the backoff after the first failed fetch with the DefaultPolicy.
+	DefaultBaseBackoff = time.Minute
+	// DefaultMaxBackoff is the longest backoff with the DefaultPolicy.
+	DefaultMaxBackoff = 24 * time.Hour
+)
+
+// DefaultPolicy is the backoff policy used by State.Backoff and State.NextFetch.
+var DefaultPolicy = Policy{Base: DefaultBaseBackoff, Max: DefaultMaxBackoff}
+
+// Policy is an exponential backoff policy.
+type Policy struct {
+	// Base is the backoff after the first failed fetch. It doubles with each further consecutive failure.
+	Base time.Duration
+	// Max is the longest backoff.
+	Max time.Duration
+}
+
+// Backoff returns the backoff after the given number of consecutive failed fetches, which is zero if there have been
+// no failures.
+func (p Policy) Backoff(failures int) time.Duration {
+	if failures <= 0 || p.Base <= 0 {
+		return 0
+	}
+	backoff := p.Base
+	for range failures - 1 {
+		backoff *= 2
+		if p.Max > 0 && backoff >= p.Max {
+			return p.Max
+		}
+	}
+	if p.Max > 0 {
+		return min(backoff, p.Max)
+	}
+	return backoff
+}
+
+// State is the fetch state of a feed. The zero value is the state of a feed that has never been fetched. A State is not
+// safe for concurrent use.
+type State struct {
+	// URL is the URL of the feed.
+	URL string `json:"url,omitempty"`
+	// ETag is the value of the ETag header of the last successful response, sent as If-None-Match in the next request.
+	ETag string `json:"etag,omitempty"`
+	// LastModified is the value of the Last-Modified header of the last successful response, sent as If-Modified-Since
+	// in the next request.
+	LastModified string `json:"last_modified,omitempty"`
+	// LastFetch is when the feed was last fetched, whether successfully or not.
+	LastFetch time.Time `json:"last_fetch,omitzero"`
+	// LastSuccess is when the feed was last fetched successfully, including when it was not modified.
+	LastSuccess time.Time `json:"last_success,omitzero"`
+	// LastError is the error of the last fetch, if it failed.
+	LastError string `json:"last_error,omitempty"`
+	// ConsecutiveErrors is the number of fetches in a row that have failed.
+	ConsecutiveErrors int `json:"consecutive_errors"`
+}
+
+// RecordSuccess records a successful fetch at the given time, with the given ETag and Last-Modified header values
+// (which may be empty if the server did not send them).
+func (s *State) RecordSuccess(at time.Time, etag, lastModified string) {
+	s.LastFetch = at
+	s.LastSuccess = at
+	s.ETag = etag
+	s.LastModified = lastModified
+	s.LastError = ""
+	s.ConsecutiveErrors = 0
+}
+
+// RecordNotModified records a successful fetch at the given time for which the server reported the feed had not been
+// modified since the last fetch. The existing ETag and Last-Modified values are kept.
+func (s *State) RecordNotModified(at time.Time) {
+	s.LastFetch = at
+	s.LastSuccess = at
+	s.LastError = ""
+	s.ConsecutiveErrors = 0
+}
+
+// RecordFailure records a failed fetch at the given time, with the given error.
+func (s *State) RecordFailure(at time.Time, err error) {
+	s.LastFetch = at
+	s.ConsecutiveErrors++
+	if err != nil {
+		s.LastError = err.Error()
+	}
+}
+
+// Healthy reports whether the last fetch of the feed, if any, succeeded.
+func (s *State) Healthy() bool {
+	return s.ConsecutiveErrors == 0
+}
+
+// Backoff returns how long to wait after the last fetch before fetching the feed again, because of consecutive failed
+// fetches, using the DefaultPolicy. It is zero if the last fetch succeeded.
+func (s *State) Backoff() time.Duration {
+	return DefaultPolicy.Backoff(s.ConsecutiveErrors)
+}
+
+// NextFetch returns when the feed should next be fetched, given the interval at which it is normally fetched. After a
+// failed fetch, the backoff is used instead of the interval, if it is longer. A feed that has never been fetched should
+// be fetched immediately, so the zero time is returned.
+func (s *State) NextFetch(interval time.Duration) time.Time {
+	if s.LastFetch.IsZero() {
+		return time.Time{}
+	}
+	return s.LastFetch.Add(max(interval, s.Backoff()))
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feedstate
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyBackoff(t *testing.T) {
+	policy := Policy{Base: time.Minute, Max: time.Hour}
+	tests := map[int]time.Duration{
+		0:    0,
+		1:    time.Minute,
+		2:    2 * time.Minute,
+		3:    4 * time.Minute,
+		7:    time.Hour,
+		1000: time.Hour,
+	}
+	for failures, want := range tests {
+		assert.Equal(t, want, policy.Backoff(failures), "failures: %d", failures)
+	}
+}
+
+func TestState(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	var state State
+	assert.True(t, state.NextFetch(time.Hour).IsZero())
+
+	state.RecordSuccess(start, `"v1"`, "Sun, 01 Mar 2026 11:00:00 GMT")
+	assert.True(t, state.Healthy())
+	assert.Equal(t, start.Add(time.Hour), state.NextFetch(time.Hour))
+
+	state.RecordFailure(start.Add(time.Hour), errors.New("503 Service Unavailable"))
+	state.RecordFailure(start.Add(2*time.Hour), errors.New("503 Service Unavailable"))
+	assert.False(t, state.Healthy())
+	assert.Equal(t, 2, state.ConsecutiveErrors)
+	assert.Equal(t, 2*DefaultBaseBackoff, state.Backoff())
+	// The normal interval is longer than the backoff.
+	assert.Equal(t, start.Add(3*time.Hour), state.NextFetch(time.Hour))
+	assert.Equal(t, start.Add(2*time.Hour+2*time.Minute), state.NextFetch(time.Minute))
+	// Validators are kept so the next request can still be conditional.
+	assert.Equal(t, `"v1"`, state.ETag)
+
+	state.RecordNotModified(start.Add(3 * time.Hour))
+	assert.True(t, state.Healthy())
+	assert.Empty(t, state.LastError)
+	assert.Equal(t, start.Add(3*time.Hour), state.LastSuccess)
+	assert.Equal(t, `"v1"`, state.ETag)
+
+	data, err := json.Marshal(&state)
+	require.NoError(t, err)
+	var got State
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, state, got)
+}
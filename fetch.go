@@ -0,0 +1,224 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// feedAcceptHeader is the Accept header sent when fetching a feed, preferring feed formats over generic XML/JSON.
+const feedAcceptHeader = "application/rss+xml, application/atom+xml, application/feed+json, application/rdf+xml, " +
+	"application/xml;q=0.9, application/json;q=0.9, text/xml;q=0.8, */*;q=0.5"
+
+// feedContentTypes are the media types that servers can correctly use for each format of feed. Generic XML and JSON
+// media types are accepted for the formats based on them.
+var feedContentTypes = map[types.SourceType][]string{
+	types.SourceTypeRSS:      {"application/rss+xml", "application/xml", "text/xml"},
+	types.SourceTypeAtom:     {"application/atom+xml", "application/xml", "text/xml"},
+	types.SourceTypeRDF:      {"application/rdf+xml", "application/rss+xml", "application/xml", "text/xml"},
+	types.SourceTypeJSONFeed: {"application/feed+json", "application/json"},
+}
+
+// urlResolver is a FeedSource whose URLs may be relative to the URL it was fetched from (i.e., an Atom feed).
+type urlResolver interface {
+	ResolveURLs(base string)
+}
+
+var (
+	// ErrFetch indicates an error occurred trying to fetch a feed.
+	ErrFetch = errors.New("unable to fetch feed")
+	// ErrNotModified indicates a feed was not fetched because it has not been modified since it was last fetched, as
+	// reported by the server in response to a conditional request made with the WithFetchState option.
+	ErrNotModified = errors.New("feed not modified")
+)
+
+// NewFeedFromURL will fetch the feed at the given URL and create a new Feed from the response, detecting the format of
+// the feed from its content. The response body is decoded as it is read. Options can be passed to configure the HTTP
+// client, timeout and logger used for the fetch, as well as the Feed. If the feed does not declare its own source URL,
+// the given URL is recorded as its source URL, so that the Feed can later be refreshed with Feed.Refresh.
+//
+// The Content-Type of the response is not relied upon, as servers often get it wrong (e.g., serving Atom as text/html
+// or RSS as text/plain). The Content-Type the feed was served with is recorded, see Feed.ContentType and
+// Feed.ContentTypeMismatch.
+func NewFeedFromURL(ctx context.Context, feedURL string, options ...Option) (*Feed, error) {
+	return fetchFeed(ctx, feedURL, newConfig(options...))
+}
+
+// fetchFeed will fetch the feed at the given URL, using the given config. If the feed does not declare its own source
+// URL, the given URL is recorded as its source URL.
+func fetchFeed(ctx context.Context, feedURL string, cfg *config) (*Feed, error) {
+	feed, _, err := fetchFeedResponse(ctx, feedURL, cfg)
+	return feed, err
+}
+
+// fetchFeedResponse is fetchFeed, but also returns the response the feed was fetched from, if a response was received,
+// whether or not the feed could be decoded from it. The body of the response will have been read and closed.
+func fetchFeedResponse(ctx context.Context, feedURL string, cfg *config) (*Feed, *resty.Response, error) {
+	sourceURL, err := url.Parse(feedURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrFetch, err)
+	}
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+	client := cfg.httpClient()
+	logger := cfg.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	req := client.R().
+		SetContext(ctx).
+		SetHeader("Accept", feedAcceptHeader).
+		SetDoNotParseResponse(true)
+	if state := cfg.fetchState; state != nil {
+		// Only make a conditional request if the validators were recorded for this URL.
+		if state.URL == sourceURL.String() {
+			if state.ETag != "" {
+				req.SetHeader("If-None-Match", state.ETag)
+			}
+			if state.LastModified != "" {
+				req.SetHeader("If-Modified-Since", state.LastModified)
+			}
+		}
+		state.URL = sourceURL.String()
+	}
+
+	logger.DebugContext(ctx, "Fetching feed.", slog.String("url", sourceURL.String()))
+	resp, err := req.Get(sourceURL.String())
+	if err != nil {
+		return nil, resp, cfg.recordFetchFailure(fmt.Errorf("%w: %w", ErrFetch, err))
+	}
+	defer resp.RawBody().Close()
+	if resp.StatusCode() == http.StatusNotModified {
+		logger.DebugContext(ctx, "Feed not modified.", slog.String("url", sourceURL.String()))
+		if cfg.fetchState != nil {
+			cfg.fetchState.RecordNotModified(time.Now().UTC())
+		}
+		return nil, resp, ErrNotModified
+	}
+	if resp.IsError() {
+		statusErr := &StatusError{Status: resp.Status(), StatusCode: resp.StatusCode()}
+		return nil, resp, cfg.recordFetchFailure(fmt.Errorf("%w: %w", ErrFetch, statusErr))
+	}
+	logger.DebugContext(ctx, "Fetched feed.",
+		slog.String("url", sourceURL.String()),
+		slog.String("status", resp.Status()),
+		slog.String("content_type", resp.Header().Get("Content-Type")))
+
+	var body io.Reader = resp.RawBody()
+	if resp.Header().Get("Content-Encoding") == "gzip" {
+		// The client requested compression itself, so the body must be uncompressed here.
+		reader, err := gzip.NewReader(resp.RawBody())
+		if err != nil {
+			return nil, resp, cfg.recordFetchFailure(fmt.Errorf("%w: %w", ErrFetch, err))
+		}
+		defer reader.Close()
+		body = reader
+	}
+
+	// Skipping certificate verification must be opted in to on each fetch, so it is not kept for a Feed.Refresh.
+	feedCfg := cfg.with()
+	feedCfg.insecureTLS = false
+	feed, err := newFeedFromReader(body, feedCfg)
+	if err != nil {
+		return nil, resp, cfg.recordFetchFailure(err)
+	}
+	finalURL := sourceURL.String()
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		finalURL = resp.RawResponse.Request.URL.String()
+	}
+	if source, ok := feed.FeedSource.(urlResolver); ok {
+		// Resolve against the URL the feed was finally served from, after any redirects.
+		source.ResolveURLs(finalURL)
+	}
+	// The source URL declared by the publisher takes precedence over the URL the feed was fetched from.
+	if feed.GetSourceURL() == "" {
+		feed.SetSourceURL(sourceURL.String())
+	}
+	feed.fetchURLs = []string{sourceURL.String(), finalURL}
+	feed.contentType = resp.Header().Get("Content-Type")
+	if feed.ContentTypeMismatch() {
+		logger.DebugContext(ctx, "Feed served with wrong content type.",
+			slog.String("url", sourceURL.String()),
+			slog.String("content_type", feed.contentType),
+			slog.String("format", string(feed.SourceType)))
+	}
+	if cfg.fetchState != nil {
+		cfg.fetchState.RecordSuccess(time.Now().UTC(), resp.Header().Get("ETag"), resp.Header().Get("Last-Modified"))
+	}
+	return feed, resp, nil
+}
+
+// ContentType returns the Content-Type header of the response the Feed was fetched from, if it was created with
+// NewFeedFromURL or refreshed with Feed.Refresh. Otherwise, an empty string is returned.
+func (f *Feed) ContentType() string {
+	return f.contentType
+}
+
+// ContentTypeMismatch reports whether the Feed was fetched from a response with a Content-Type that does not match its
+// format, such as Atom served as text/html. The Feed was still decoded, as its format is detected from its content.
+// If the Feed was not fetched or the response had no Content-Type, false is returned.
+func (f *Feed) ContentTypeMismatch() bool {
+	if f.contentType == "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(f.contentType)
+	if err != nil {
+		return true
+	}
+	if registered, found := lookupFormat(f.SourceType); found {
+		return !slices.Contains(registered.mimeTypes, mediaType)
+	}
+	return !slices.Contains(feedContentTypes[f.SourceType], mediaType)
+}
+
+// FetchURL returns the URL the Feed was served from, after any redirects, if it was created with NewFeedFromURL or
+// refreshed with Feed.Refresh. Otherwise, an empty string is returned.
+func (f *Feed) FetchURL() string {
+	if len(f.fetchURLs) == 0 {
+		return ""
+	}
+	return f.fetchURLs[len(f.fetchURLs)-1]
+}
+
+// SourceURLMismatch reports whether the Feed was fetched from a URL other than the source URL it declares (e.g., its
+// rel="self" link), such as a feed that has moved without updating its self link, or one that is mirrored. The URL
+// that was requested and the URL the Feed was served from, after any redirects, are both compared, ignoring
+// differences that do not change the URL (see Item.Key). The declared source URL is kept as is. If the Feed was not
+// fetched or does not declare a source URL, false is returned.
+func (f *Feed) SourceURLMismatch() bool {
+	sourceURL := f.GetSourceURL()
+	if len(f.fetchURLs) == 0 || sourceURL == "" {
+		return false
+	}
+	sourceURL = canonicalizeURL(sourceURL)
+	return !slices.ContainsFunc(f.fetchURLs, func(fetchURL string) bool {
+		return canonicalizeURL(fetchURL) == sourceURL
+	})
+}
+
+// recordFetchFailure records the given fetch error in any configured fetch state, and returns it.
+func (c *config) recordFetchFailure(err error) error {
+	if c.fetchState != nil {
+		c.fetchState.RecordFailure(time.Now().UTC(), err)
+	}
+	return err
+}
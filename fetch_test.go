@@ -0,0 +1,331 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/feedstate"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestNewFeedFromURL(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, streamTests[types.SourceTypeRSS])
+	})
+	mux.HandleFunc("/feed.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/feed+json")
+		_, _ = io.WriteString(w, streamTests[types.SourceTypeJSONFeed])
+	})
+	mux.HandleFunc("/atom.html", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, streamTests[types.SourceTypeAtom])
+	})
+	mux.HandleFunc("/rss.txt", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, streamTests[types.SourceTypeRSS])
+	})
+	mux.HandleFunc("/gzip.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = io.WriteString(gz, streamTests[types.SourceTypeAtom])
+		_ = gz.Close()
+	})
+	mux.HandleFunc("/slow.xml", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name         string
+		path         string
+		options      []Option
+		want         types.SourceType
+		wantErr      error
+		numItems     int
+		wantMismatch bool
+	}{
+		{
+			name:     "rss",
+			path:     "/feed.xml",
+			want:     types.SourceTypeRSS,
+			numItems: 3,
+		},
+		{
+			name:     "jsonfeed with options",
+			path:     "/feed.json",
+			options:  []Option{WithMaxItems(2)},
+			want:     types.SourceTypeJSONFeed,
+			numItems: 2,
+		},
+		{
+			name:         "atom served as html",
+			path:         "/atom.html",
+			want:         types.SourceTypeAtom,
+			numItems:     3,
+			wantMismatch: true,
+		},
+		{
+			name:         "rss served as text",
+			path:         "/rss.txt",
+			want:         types.SourceTypeRSS,
+			numItems:     3,
+			wantMismatch: true,
+		},
+		{
+			name:     "gzip with client",
+			path:     "/gzip.xml",
+			options:  []Option{WithClient(resty.New().SetHeader("Accept-Encoding", "gzip"))},
+			want:     types.SourceTypeAtom,
+			numItems: 3,
+		},
+		{
+			name:    "not found",
+			path:    "/missing.xml",
+			wantErr: ErrFetch,
+		},
+		{
+			name:    "timeout",
+			path:    "/slow.xml",
+			options: []Option{WithTimeout(50 * time.Millisecond)},
+			wantErr: ErrFetch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromURL(t.Context(), server.URL+tt.path, tt.options...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, feed.SourceType)
+			assert.Len(t, feed.GetItems(), tt.numItems)
+			assert.Equal(t, tt.wantMismatch, feed.ContentTypeMismatch(), feed.ContentType())
+		})
+	}
+}
+
+func TestNewFeedFromURLFetchState(t *testing.T) {
+	const etag = `"v1"`
+	var status atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code := int(status.Load()); code != 0 {
+			w.WriteHeader(code)
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Header().Set("ETag", etag)
+		_, _ = io.WriteString(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Example Feed</title>`+
+			`<link>https://example.com/</link><description>Example</description></channel></rss>`)
+	}))
+	t.Cleanup(server.Close)
+
+	state := &feedstate.State{}
+	feed, err := NewFeedFromURL(t.Context(), server.URL, WithFetchState(state))
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, state.URL)
+	assert.Equal(t, etag, state.ETag)
+	assert.True(t, state.Healthy())
+	assert.False(t, state.LastSuccess.IsZero())
+
+	// The stored ETag is sent, and the server reports the feed is not modified.
+	_, err = NewFeedFromURL(t.Context(), server.URL, WithFetchState(state))
+	require.ErrorIs(t, err, ErrNotModified)
+	result, err := feed.Refresh(t.Context(), WithFetchState(state))
+	require.NoError(t, err)
+	assert.False(t, result.Changed())
+	assert.Zero(t, state.ConsecutiveErrors)
+
+	// Failures are counted.
+	status.Store(http.StatusInternalServerError)
+	_, err = NewFeedFromURL(t.Context(), server.URL, WithFetchState(state))
+	require.ErrorIs(t, err, ErrFetch)
+	assert.Equal(t, 1, state.ConsecutiveErrors)
+	assert.False(t, state.Healthy())
+	assert.NotEmpty(t, state.LastError)
+}
+
+func TestNewFeedFromURLRelativeAtomURLs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/old.xml", http.RedirectHandler("/feeds/atom.xml", http.StatusMovedPermanently))
+	mux.HandleFunc("/feeds/atom.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example Feed</title>
+  <id>urn:uuid:60a76c80-d399-11d9-b93C-0003939e0af6</id>
+  <updated>2003-12-13T18:30:02Z</updated>
+  <author><name>John Doe</name></author>
+  <link rel="alternate" href="/"/>
+  <logo>images/logo.png</logo>
+  <icon>https://cdn.example.com/icon.png</icon>
+  <entry>
+    <title>First</title>
+    <id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+    <updated>2003-12-13T18:30:02Z</updated>
+    <link href="posts/first"/>
+  </entry>
+  <entry>
+    <title>Second</title>
+    <id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6b</id>
+    <updated>2003-12-13T18:30:02Z</updated>
+    <link href="../second"/>
+  </entry>
+</feed>`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	feed, err := NewFeedFromURL(t.Context(), server.URL+"/old.xml")
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/", feed.GetLink())
+	require.NotNil(t, feed.GetImage())
+	assert.Equal(t, server.URL+"/feeds/images/logo.png", feed.GetImage().URL)
+	items := feed.GetItems()
+	require.Len(t, items, 2)
+	assert.Equal(t, server.URL+"/feeds/posts/first", items[0].GetLink())
+	assert.Equal(t, server.URL+"/second", items[1].GetLink())
+}
+
+func TestSetSourceURLRepeatedFetches(t *testing.T) {
+	feeds := map[string]string{
+		"atom": `<feed xmlns="http://www.w3.org/2005/Atom"><title>Atom</title><id>urn:example</id>` +
+			`<updated>2026-01-01T00:00:00Z</updated><link rel="self" href="/old.xml"/>` +
+			`<link rel="self" type="text/html" href="https://example.com/"/></feed>`,
+		"rss": `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>RSS</title>` +
+			`<atom:link rel="self" href="/old.xml"/><atom:link rel="hub" href="https://hub.example.com/"/>` +
+			`</channel></rss>`,
+		"jsonfeed": `{"version":"https://jsonfeed.org/version/1.1","title":"JSON","feed_url":"/old.json","items":[]}`,
+	}
+	mux := http.NewServeMux()
+	for name, data := range feeds {
+		mux.HandleFunc("/"+name, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, data)
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	for name := range feeds {
+		t.Run(name, func(t *testing.T) {
+			feedURL := server.URL + "/" + name
+			feed, err := NewFeedFromURL(t.Context(), feedURL)
+			require.NoError(t, err)
+			feed.SetSourceURL(feedURL)
+			for range 2 {
+				_, err = feed.Refresh(t.Context())
+				require.NoError(t, err)
+				feed.SetSourceURL(feedURL)
+			}
+
+			self := feed.GetLinksByRel(LinkRelSelf)
+			if name == "atom" {
+				// The self link to the HTML page is not the source URL, so it is kept.
+				require.Len(t, self, 2)
+				assert.Equal(t, "https://example.com/", feed.GetLink())
+			} else {
+				require.Len(t, self, 1)
+			}
+			assert.Equal(t, feedURL, self[0].Href)
+			assert.Equal(t, feedURL, feed.GetSourceURL())
+		})
+	}
+}
+
+func TestSourceURLMismatch(t *testing.T) {
+	selfLink := func(href string) string {
+		return `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Example</title>` +
+			`<atom:link rel="self" href="` + href + `"/></channel></rss>`
+	}
+	mux := http.NewServeMux()
+	var serverURL string
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, selfLink(serverURL+"/feed.xml?utm_source=feed"))
+	})
+	mux.HandleFunc("/mirror.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, selfLink("https://example.com/feed.xml"))
+	})
+	mux.HandleFunc("/undeclared.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `<rss version="2.0"><channel><title>Example</title></channel></rss>`)
+	})
+	mux.Handle("/old.xml", http.RedirectHandler("/feed.xml", http.StatusMovedPermanently))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	serverURL = server.URL
+
+	tests := []struct {
+		path       string
+		wantSource string
+		wantFetch  string
+		mismatch   bool
+	}{
+		{path: "/feed.xml", wantSource: server.URL + "/feed.xml?utm_source=feed", wantFetch: server.URL + "/feed.xml"},
+		{path: "/old.xml", wantSource: server.URL + "/feed.xml?utm_source=feed", wantFetch: server.URL + "/feed.xml"},
+		{
+			path: "/mirror.xml", wantSource: "https://example.com/feed.xml", wantFetch: server.URL + "/mirror.xml",
+			mismatch: true,
+		},
+		{
+			path: "/undeclared.xml", wantSource: server.URL + "/undeclared.xml",
+			wantFetch: server.URL + "/undeclared.xml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			feed, err := NewFeedFromURL(t.Context(), server.URL+tt.path)
+			require.NoError(t, err)
+			// The source URL declared by the publisher is kept.
+			assert.Equal(t, tt.wantSource, feed.GetSourceURL())
+			assert.Equal(t, tt.wantFetch, feed.FetchURL())
+			assert.Equal(t, tt.mismatch, feed.SourceURLMismatch())
+			assert.Equal(t, tt.mismatch, feed.Clone().SourceURLMismatch())
+		})
+	}
+
+	feed, err := NewFeedFromBytes([]byte(selfLink("https://example.com/feed.xml")))
+	require.NoError(t, err)
+	assert.Empty(t, feed.FetchURL())
+	assert.False(t, feed.SourceURLMismatch())
+}
+
+func TestNewFeedFromURLInsecureTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `<rss version="2.0"><channel><title>Example</title></channel></rss>`)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewFeedFromURL(t.Context(), server.URL)
+	require.ErrorIs(t, err, ErrFetch)
+
+	feed, err := NewFeedFromURL(t.Context(), server.URL, WithInsecureTLS())
+	require.NoError(t, err)
+	assert.Equal(t, "Example", feed.GetTitle())
+
+	// Skipping verification is not kept for later fetches of the feed.
+	_, err = feed.Refresh(t.Context())
+	require.ErrorIs(t, err, ErrFetch)
+	_, err = feed.Refresh(t.Context(), WithInsecureTLS())
+	require.NoError(t, err)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// ErrFormat indicates a feed format could not be registered.
+var ErrFormat = errors.New("unable to register format")
+
+// SniffFunc reports whether the given data, the start of a document (up to the first 4096 bytes), is in a format.
+type SniffFunc func(peek []byte) bool
+
+// DecodeFunc decodes a feed in a format from the given io.Reader.
+type DecodeFunc[F types.FeedSource] func(r io.Reader) (F, error)
+
+// format is a feed format registered with RegisterFormat.
+type format struct {
+	name      types.SourceType
+	mimeTypes []string
+	sniff     SniffFunc
+	decode    func(r io.Reader) (types.FeedSource, error)
+	// isSource reports whether the given value is a source of the format.
+	isSource      func(source any) bool
+	unmarshalFeed func(data json.RawMessage) (types.FeedSource, error)
+	unmarshalItem func(data json.RawMessage) (types.ItemSource, error)
+}
+
+// formats are the formats registered with RegisterFormat, in the order they were registered.
+var formats struct {
+	sync.RWMutex
+
+	registered []*format
+}
+
+// RegisterFormat registers a feed format, in addition to those supported by this package, so that feeds in the format
+// are detected and decoded by NewFeedFromReader, NewFeedFromBytes and NewFeedFromURL, and a Feed or Item of the format
+// can be round-tripped through JSON. F is the FeedSource type of the format and I is the ItemSource type of its items,
+// both of which must be able to be marshaled to and unmarshaled from JSON.
+//
+// The name is used as the SourceType of a Feed or Item of the format. The mimeTypes are those a server can correctly
+// serve the format as, see Feed.ContentTypeMismatch. The sniff function is called with the start of a document to detect
+// the format, before any of the formats supported by this package, and the decode function is called to decode a
+// document detected as the format.
+//
+// An ErrFormat error is returned if the name is empty, is already registered or is the name of a format supported by
+// this package, or if sniff or decode are nil.
+func RegisterFormat[F types.FeedSource, I types.ItemSource](
+	name types.SourceType, mimeTypes []string, sniff SniffFunc, decode DecodeFunc[F],
+) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("%w: no name", ErrFormat)
+	case name.Valid():
+		return fmt.Errorf("%w: %s is a built-in format", ErrFormat, name)
+	case sniff == nil || decode == nil:
+		return fmt.Errorf("%w: %s: sniff and decode functions are required", ErrFormat, name)
+	}
+
+	formats.Lock()
+	defer formats.Unlock()
+	if slices.ContainsFunc(formats.registered, func(f *format) bool { return f.name == name }) {
+		return fmt.Errorf("%w: %s is already registered", ErrFormat, name)
+	}
+	formats.registered = append(formats.registered, &format{
+		name:      name,
+		mimeTypes: slices.Clone(mimeTypes),
+		sniff:     sniff,
+		decode: func(r io.Reader) (types.FeedSource, error) {
+			return decode(r)
+		},
+		isSource: func(source any) bool {
+			_, ok := source.(F)
+			return ok
+		},
+		unmarshalFeed: func(data json.RawMessage) (types.FeedSource, error) {
+			return unmarshalSource[F](data)
+		},
+		unmarshalItem: func(data json.RawMessage) (types.ItemSource, error) {
+			return unmarshalSource[I](data)
+		},
+	})
+	return nil
+}
+
+// lookupFormat returns the registered format with the given name, if any.
+func lookupFormat(name types.SourceType) (*format, bool) {
+	formats.RLock()
+	defer formats.RUnlock()
+	for registered := range slices.Values(formats.registered) {
+		if registered.name == name {
+			return registered, true
+		}
+	}
+	return nil, false
+}
+
+// sniffFormat returns the name of the first registered format that the given start of a document is in, if any.
+func sniffFormat(peek []byte) (types.SourceType, bool) {
+	formats.RLock()
+	defer formats.RUnlock()
+	for registered := range slices.Values(formats.registered) {
+		if registered.sniff(peek) {
+			return registered.name, true
+		}
+	}
+	return "", false
+}
+
+// formatOf returns the name of the registered format of the given source, if any.
+func formatOf(source any) (types.SourceType, bool) {
+	formats.RLock()
+	defer formats.RUnlock()
+	for registered := range slices.Values(formats.registered) {
+		if registered.isSource(source) {
+			return registered.name, true
+		}
+	}
+	return "", false
+}
+
+// decodeFormat creates a new Feed by decoding the document in the given io.Reader with the given registered format,
+// using the given config.
+func decodeFormat(registered *format, data io.Reader, cfg *config) (*Feed, error) {
+	start := time.Now()
+	counter := &countingReader{reader: data}
+	source, err := registered.decode(cfg.limitReader(counter))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	feed := &Feed{
+		FeedSource: source,
+		SourceType: registered.name,
+		config:     cfg,
+		validation: &validationResult{},
+	}
+	if err := feed.finishDecode(start, counter.count); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	return feed, nil
+}
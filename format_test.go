@@ -0,0 +1,109 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// sourceTypeText is a plain text format registered for testing, with a title on the first line after the signature
+// and an item title on each following line.
+const sourceTypeText types.SourceType = "Text"
+
+type textFeed struct {
+	jsonfeed.Feed
+}
+
+type textItem struct {
+	jsonfeed.Item
+}
+
+func init() {
+	err := RegisterFormat[*textFeed, *textItem](sourceTypeText, []string{"text/x-feed"},
+		func(peek []byte) bool {
+			return bytes.HasPrefix(peek, []byte("#textfeed\n"))
+		},
+		func(r io.Reader) (*textFeed, error) {
+			scanner := bufio.NewScanner(r)
+			scanner.Scan() // signature
+			feed := &textFeed{}
+			if scanner.Scan() {
+				feed.Title = scanner.Text()
+			}
+			for scanner.Scan() {
+				feed.Items = append(feed.Items, jsonfeed.Item{ID: scanner.Text(), Title: new(scanner.Text())})
+			}
+			return feed, scanner.Err()
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	sniff := func([]byte) bool { return false }
+	decode := func(io.Reader) (*textFeed, error) { return &textFeed{}, nil }
+	require.ErrorIs(t, RegisterFormat[*textFeed, *textItem](sourceTypeText, nil, sniff, decode), ErrFormat)
+	require.ErrorIs(t, RegisterFormat[*textFeed, *textItem](types.SourceTypeRSS, nil, sniff, decode), ErrFormat)
+	require.ErrorIs(t, RegisterFormat[*textFeed, *textItem]("", nil, sniff, decode), ErrFormat)
+	require.ErrorIs(t, RegisterFormat[*textFeed, *textItem]("Other", nil, nil, decode), ErrFormat)
+}
+
+func TestRegisteredFormat(t *testing.T) {
+	data := []byte("#textfeed\nText Feed\nFirst\nSecond\n")
+	assert.Equal(t, sourceTypeText, DetectFormat(data))
+
+	feed, err := NewFeedFromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, sourceTypeText, feed.SourceType)
+	assert.Equal(t, "Text Feed", feed.GetTitle())
+	items := feed.GetItems()
+	require.Len(t, items, 2)
+	assert.Equal(t, sourceTypeText, items[0].SourceType)
+	assert.Equal(t, "First", items[0].GetTitle())
+	assert.Equal(t, sourceTypeText, NewFeedFromSource(&textFeed{}).SourceType)
+
+	// Feeds and items of the format can be round-tripped through JSON.
+	encoded, err := json.Marshal(feed)
+	require.NoError(t, err)
+	var decoded Feed
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, sourceTypeText, decoded.SourceType)
+	assert.IsType(t, &textFeed{}, decoded.FeedSource)
+	assert.Equal(t, "Text Feed", decoded.GetTitle())
+
+	encoded, err = json.Marshal(&items[1])
+	require.NoError(t, err)
+	var decodedItem Item
+	require.NoError(t, json.Unmarshal(encoded, &decodedItem))
+	assert.IsType(t, &textItem{}, decodedItem.ItemSource)
+	assert.Equal(t, "Second", decodedItem.GetTitle())
+}
+
+func TestRegisteredFormatFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/x-feed")
+		_, _ = io.Copy(w, strings.NewReader("#textfeed\nFetched\nOnly\n"))
+	}))
+	defer server.Close()
+
+	feed, err := NewFeedFromURL(t.Context(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, sourceTypeText, feed.SourceType)
+	assert.Equal(t, "Fetched", feed.GetTitle())
+	assert.False(t, feed.ContentTypeMismatch())
+}
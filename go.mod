@@ -3,10 +3,13 @@ module github.com/immanent-tech/go-syndication
 go 1.26.5
 
 require (
+	github.com/fxamacker/cbor/v2 v2.9.0
 	github.com/go-playground/validator/v10 v10.30.3
 	github.com/go-resty/resty/v2 v2.17.2
 	github.com/microcosm-cc/bluemonday v1.0.27
+	github.com/vmihailenco/msgpack/v5 v5.4.1
 	golang.org/x/net v0.57.0
+	google.golang.org/protobuf v1.36.6
 )
 
 require (
@@ -19,6 +22,8 @@ require (
 	github.com/sergi/go-diff v1.4.0 // indirect
 	github.com/speakeasy-api/jsonpath v0.6.3 // indirect
 	github.com/speakeasy-api/openapi v1.24.0 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 )
 
@@ -0,0 +1,167 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// DefaultStaleAge is how old the newest item of a feed can be before CheckHealth classifies the feed as stale, if no
+// other age is given with the WithStaleAge option.
+const DefaultStaleAge = 180 * 24 * time.Hour
+
+// HealthStatus is the classification of the health of a feed by CheckHealth.
+type HealthStatus string
+
+const (
+	// HealthStatusHealthy indicates the feed could be fetched and decoded, and has recent items (or no dated items).
+	HealthStatusHealthy HealthStatus = "healthy"
+	// HealthStatusStale indicates the feed could be fetched and decoded, but has no items, or its newest item is older
+	// than the stale age.
+	HealthStatusStale HealthStatus = "stale"
+	// HealthStatusDead indicates the feed could not be fetched or decoded.
+	HealthStatusDead HealthStatus = "dead"
+	// HealthStatusMoved indicates the feed could be fetched and decoded, but only after permanent redirects to another
+	// URL, or the feed declares it has moved to another URL, which should be used instead. See Health.RedirectURL and
+	// Health.NewFeedURL.
+	HealthStatusMoved HealthStatus = "moved"
+)
+
+// newFeedURLSource is a FeedSource that can declare it has moved to a new URL (i.e., an RSS feed).
+type newFeedURLSource interface {
+	GetNewFeedURL() string
+}
+
+// GetNewFeedURL returns the URL the Feed declares it has moved to, which subscribers should use instead of the URL of
+// the Feed. This is the <itunes:new-feed-url> of an RSS feed, which podcasts use to migrate subscribers to a new host.
+// For other formats, or if the Feed has not moved, an empty string is returned.
+func (f *Feed) GetNewFeedURL() string {
+	if source, ok := f.FeedSource.(newFeedURLSource); ok {
+		return source.GetNewFeedURL()
+	}
+	return ""
+}
+
+// Health is the result of checking the health of a feed with CheckHealth.
+type Health struct {
+	// Err is the error fetching or decoding the feed, if any.
+	Err error `json:"-"`
+	// ErrorClass is the class of Err, see ClassifyError.
+	ErrorClass ErrorClass `json:"error_class,omitempty"`
+	// TLSErr is the error verifying the TLS certificate of the server, if that is why the feed could not be fetched or,
+	// with the WithInsecureTLS option, if the feed was fetched despite it.
+	TLSErr error `json:"-"`
+	// TLS describes the TLS connection and certificate of the server, if the feed was fetched over HTTPS.
+	TLS *TLSInfo `json:"tls,omitempty"`
+	// NewestItem is the date of the newest item of the feed, if any item has a date.
+	NewestItem *time.Time `json:"newest_item,omitempty"`
+	// URL is the URL that was checked.
+	URL string `json:"url"`
+	// RedirectURL is the URL the feed was finally served from, if it was redirected.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// NewFeedURL is the URL the feed declares it has moved to (i.e., its <itunes:new-feed-url>), if any.
+	NewFeedURL string `json:"new_feed_url,omitempty"`
+	// ContentType is the Content-Type of the response, if any.
+	ContentType string `json:"content_type,omitempty"`
+	// Status is the classification of the health of the feed.
+	Status HealthStatus `json:"status"`
+	// StatusCode is the HTTP status code of the response, if any.
+	StatusCode int `json:"status_code,omitempty"`
+	// NewestItemAge is the age of the newest item of the feed when it was checked, if any item has a date.
+	NewestItemAge time.Duration `json:"newest_item_age,omitempty"`
+	// Items is the number of items of the feed.
+	Items int `json:"items"`
+	// Reachable reports whether the server responded with a successful status.
+	Reachable bool `json:"reachable"`
+	// Parseable reports whether a feed could be decoded from the response.
+	Parseable bool `json:"parseable"`
+	// PermanentRedirect reports whether every redirect followed to fetch the feed was permanent (301 or 308).
+	PermanentRedirect bool `json:"permanent_redirect,omitempty"`
+}
+
+// CheckHealth fetches the feed at the given URL and reports on its health: whether it is reachable and can be decoded,
+// how old its newest item is, where it was redirected to and whether the certificate of the server could be verified.
+// The feed is classified as dead if it cannot be fetched or decoded, moved if it was permanently redirected to another
+// URL or declares a new URL (see Feed.GetNewFeedURL), stale if it has no items or its newest item is older than the
+// stale age (see WithStaleAge), and otherwise healthy. Options can be passed to configure the HTTP client, timeout and
+// logger used for the fetch, as with NewFeedFromURL, though any fetch state is ignored so that the feed is always
+// fetched in full. With the WithInsecureTLS option, a feed whose server has an invalid certificate is still checked,
+// with the certificate error reported in TLSErr.
+func CheckHealth(ctx context.Context, feedURL string, options ...Option) *Health {
+	cfg := newConfig(options...)
+	cfg.fetchState = nil
+	staleAge := cfg.staleAge
+	if staleAge <= 0 {
+		staleAge = DefaultStaleAge
+	}
+
+	health := &Health{URL: feedURL}
+	feed, resp, err := fetchFeedResponse(ctx, feedURL, cfg)
+	health.Err = err
+	health.ErrorClass = ClassifyError(err)
+	if certErr, ok := errors.AsType[*tls.CertificateVerificationError](err); ok {
+		health.TLSErr = certErr
+		if u, parseErr := url.Parse(feedURL); parseErr == nil {
+			health.TLS = newTLSInfoFromError(certErr, u.Hostname())
+		}
+	}
+	if resp != nil && resp.RawResponse != nil {
+		if health.TLS = newTLSInfo(resp.RawResponse.TLS); health.TLS != nil && health.TLS.Err != nil {
+			health.TLSErr = health.TLS.Err
+		}
+		health.StatusCode = resp.StatusCode()
+		health.ContentType = resp.Header().Get("Content-Type")
+		health.Reachable = !resp.IsError()
+		if final := resp.RawResponse.Request; final != nil && final.Response != nil {
+			health.RedirectURL = final.URL.String()
+			health.PermanentRedirect = isPermanentRedirect(resp.RawResponse)
+		}
+	}
+	if feed == nil {
+		health.Status = HealthStatusDead
+		return health
+	}
+
+	health.Parseable = true
+	if newURL := feed.GetNewFeedURL(); newURL != "" && newURL != feedURL && newURL != health.RedirectURL {
+		health.NewFeedURL = newURL
+	}
+	for item := range feed.ItemsSeq() {
+		health.Items++
+		if date := itemDate(&item); date != nil && (health.NewestItem == nil || date.After(*health.NewestItem)) {
+			health.NewestItem = date
+		}
+	}
+	if health.NewestItem != nil {
+		health.NewestItemAge = time.Since(*health.NewestItem)
+	}
+
+	switch {
+	case health.RedirectURL != "" && health.PermanentRedirect, health.NewFeedURL != "":
+		health.Status = HealthStatusMoved
+	case health.Items == 0, health.NewestItemAge > staleAge:
+		health.Status = HealthStatusStale
+	default:
+		health.Status = HealthStatusHealthy
+	}
+	return health
+}
+
+// isPermanentRedirect reports whether the given response was reached by following only permanent redirects, and at
+// least one of them.
+func isPermanentRedirect(resp *http.Response) bool {
+	var codes []int
+	for req := resp.Request; req != nil && req.Response != nil; req = req.Response.Request {
+		codes = append(codes, req.Response.StatusCode)
+	}
+	return len(codes) > 0 && !slices.ContainsFunc(codes, func(code int) bool {
+		return code != http.StatusMovedPermanently && code != http.StatusPermanentRedirect
+	})
+}
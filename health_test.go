@@ -0,0 +1,171 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHealth(t *testing.T) {
+	rssWithItem := func(published time.Time) string {
+		return fmt.Sprintf(`<rss version="2.0"><channel><title>Example</title><link>https://example.com/</link>`+
+			`<description>Example</description><item><title>Item</title><pubDate>%s</pubDate></item></channel></rss>`,
+			published.Format(time.RFC1123Z))
+	}
+	recent := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	old := time.Now().AddDate(-2, 0, 0).Truncate(time.Second)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthy.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, rssWithItem(recent))
+	})
+	mux.HandleFunc("/stale.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, rssWithItem(old))
+	})
+	mux.HandleFunc("/empty.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, `<rss version="2.0"><channel><title>Example</title></channel></rss>`)
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = io.WriteString(w, `<html><body>Not a feed</body></html>`)
+	})
+	mux.HandleFunc("/podcast.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, `<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">`+
+			`<channel><title>Example</title><itunes:new-feed-url>https://example.com/podcast.xml</itunes:new-feed-url>`+
+			`</channel></rss>`)
+	})
+	mux.Handle("/moved.xml", http.RedirectHandler("/healthy.xml", http.StatusMovedPermanently))
+	mux.Handle("/temporary.xml", http.RedirectHandler("/healthy.xml", http.StatusFound))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name       string
+		path       string
+		options    []Option
+		want       HealthStatus
+		statusCode int
+		redirect   string
+		newURL     string
+		reachable  bool
+		parseable  bool
+		permanent  bool
+		newest     bool
+		items      int
+	}{
+		{
+			name: "healthy", path: "/healthy.xml", want: HealthStatusHealthy, statusCode: http.StatusOK,
+			reachable: true, parseable: true, newest: true, items: 1,
+		},
+		{
+			name: "stale", path: "/stale.xml", want: HealthStatusStale, statusCode: http.StatusOK,
+			reachable: true, parseable: true, newest: true, items: 1,
+		},
+		{
+			name: "stale age option", path: "/stale.xml", options: []Option{WithStaleAge(5 * 365 * 24 * time.Hour)},
+			want: HealthStatusHealthy, statusCode: http.StatusOK, reachable: true, parseable: true, newest: true,
+			items: 1,
+		},
+		{
+			name: "no items", path: "/empty.xml", want: HealthStatusStale, statusCode: http.StatusOK,
+			reachable: true, parseable: true,
+		},
+		{
+			name: "not found", path: "/missing.xml", want: HealthStatusDead, statusCode: http.StatusNotFound,
+		},
+		{
+			name: "not a feed", path: "/page.html", want: HealthStatusDead, statusCode: http.StatusOK, reachable: true,
+		},
+		{
+			name: "moved", path: "/moved.xml", want: HealthStatusMoved, statusCode: http.StatusOK,
+			redirect: server.URL + "/healthy.xml", reachable: true, parseable: true, permanent: true, newest: true,
+			items: 1,
+		},
+		{
+			name: "new feed url", path: "/podcast.xml", want: HealthStatusMoved, statusCode: http.StatusOK,
+			newURL: "https://example.com/podcast.xml", reachable: true, parseable: true,
+		},
+		{
+			name: "temporary redirect", path: "/temporary.xml", want: HealthStatusHealthy, statusCode: http.StatusOK,
+			redirect: server.URL + "/healthy.xml", reachable: true, parseable: true, newest: true, items: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := CheckHealth(context.Background(), server.URL+tt.path, tt.options...)
+			require.NotNil(t, health)
+			assert.Equal(t, tt.want, health.Status)
+			assert.Equal(t, server.URL+tt.path, health.URL)
+			assert.Equal(t, tt.statusCode, health.StatusCode)
+			assert.Equal(t, tt.redirect, health.RedirectURL)
+			assert.Equal(t, tt.newURL, health.NewFeedURL)
+			assert.Equal(t, tt.reachable, health.Reachable)
+			assert.Equal(t, tt.parseable, health.Parseable)
+			assert.Equal(t, tt.permanent, health.PermanentRedirect)
+			assert.Equal(t, tt.items, health.Items)
+			assert.Equal(t, tt.want == HealthStatusDead, health.Err != nil)
+			assert.NoError(t, health.TLSErr)
+			if tt.newest {
+				require.NotNil(t, health.NewestItem)
+				assert.Positive(t, health.NewestItemAge)
+			} else {
+				assert.Nil(t, health.NewestItem)
+			}
+		})
+	}
+}
+
+func TestCheckHealthTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `<rss version="2.0"><channel><title>Example</title></channel></rss>`)
+	}))
+	t.Cleanup(server.Close)
+
+	health := CheckHealth(context.Background(), server.URL)
+	assert.Equal(t, HealthStatusDead, health.Status)
+	assert.False(t, health.Reachable)
+	require.Error(t, health.Err)
+	assert.ErrorIs(t, health.Err, ErrFetch)
+	assert.Error(t, health.TLSErr)
+	require.NotNil(t, health.TLS)
+	assert.False(t, health.TLS.Verified)
+	assert.Equal(t, server.Certificate().NotAfter, health.TLS.NotAfter)
+	assert.Equal(t, server.Certificate().Issuer.String(), health.TLS.Issuer)
+
+	// The feed is fetched despite the invalid certificate, which is still reported.
+	health = CheckHealth(context.Background(), server.URL, WithInsecureTLS())
+	assert.Equal(t, HealthStatusStale, health.Status)
+	assert.True(t, health.Reachable)
+	require.NoError(t, health.Err)
+	assert.Error(t, health.TLSErr)
+	require.NotNil(t, health.TLS)
+	assert.False(t, health.TLS.Verified)
+	assert.ErrorIs(t, health.TLS.Err, health.TLSErr)
+	assert.NotEmpty(t, health.TLS.Version)
+	assert.NotEmpty(t, health.TLS.CipherSuite)
+	assert.Equal(t, server.Certificate().Subject.String(), health.TLS.Subject)
+	assert.False(t, health.TLS.ExpiresWithin(time.Hour))
+
+	// A client that trusts the certificate verifies it.
+	health = CheckHealth(context.Background(), server.URL, WithClient(resty.NewWithClient(server.Client())))
+	assert.Equal(t, HealthStatusStale, health.Status)
+	require.NoError(t, health.TLSErr)
+	require.NotNil(t, health.TLS)
+	assert.True(t, health.TLS.Verified)
+	assert.NoError(t, health.TLS.Err)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"slices"
+	"time"
+)
+
+// maxSkippedHours is the most hours NextUpdate will skip over, which is a whole week.
+const maxSkippedHours = 7 * 24
+
+// skipHintsSource is a FeedSource with hints on when it should not be fetched (i.e., an RSS feed).
+type skipHintsSource interface {
+	GetTTL() time.Duration
+	GetSkipHours() []int
+	GetSkipDays() []time.Weekday
+}
+
+// UpdateHints are the hints a Feed gives aggregators about how often to fetch it, and when not to.
+type UpdateHints struct {
+	// Interval is the expected interval between updates of the Feed. See types.FeedSource.GetUpdateInterval.
+	Interval time.Duration `json:"interval"`
+	// TTL is how long the Feed can be cached before it should be fetched again, if it specifies one.
+	TTL time.Duration `json:"ttl,omitempty"`
+	// SkipHours are the hours of the day (0-23, in GMT) during which the Feed should not be fetched.
+	SkipHours []int `json:"skip_hours,omitempty"`
+	// SkipDays are the days of the week (in GMT) during which the Feed should not be fetched.
+	SkipDays []time.Weekday `json:"skip_days,omitempty"`
+}
+
+// GetUpdateHints returns the hints of the Feed about how often to fetch it, and when not to, regardless of its format.
+// Only RSS feeds have a TTL and hours or days to skip; for other formats, only the Interval is set.
+func (f *Feed) GetUpdateHints() UpdateHints {
+	hints := UpdateHints{Interval: f.GetUpdateInterval()}
+	if source, ok := f.FeedSource.(skipHintsSource); ok {
+		hints.TTL = source.GetTTL()
+		hints.SkipHours = source.GetSkipHours()
+		hints.SkipDays = source.GetSkipDays()
+	}
+	return hints
+}
+
+// ShouldSkip reports whether the Feed should not be fetched at the given time, because its hour or day (in GMT) is one
+// to skip.
+func (h UpdateHints) ShouldSkip(t time.Time) bool {
+	t = t.UTC()
+	return slices.Contains(h.SkipHours, t.Hour()) || slices.Contains(h.SkipDays, t.Weekday())
+}
+
+// NextUpdate returns when the Feed should next be fetched, given when it was last fetched. This is after the longer of
+// the Interval and TTL, moved forward to the start of the first hour that is not to be skipped. If every hour is to be
+// skipped, the hints are ignored and the time after the Interval and TTL is returned.
+func (h UpdateHints) NextUpdate(last time.Time) time.Time {
+	next := last.Add(max(h.Interval, h.TTL))
+	candidate := next
+	for range maxSkippedHours {
+		if !h.ShouldSkip(candidate) {
+			return candidate
+		}
+		candidate = candidate.Truncate(time.Hour).Add(time.Hour)
+	}
+	return next
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestFeedGetUpdateHints(t *testing.T) {
+	data := []byte(`<rss version="2.0"><channel><title>Example</title><link>https://example.org/</link>` +
+		`<description>Example</description><ttl>90</ttl>` +
+		`<skipHours><hour>0</hour><hour>1</hour><hour>24</hour><hour>25</hour></skipHours>` +
+		`<skipDays><day>Saturday</day><day>sunday</day><day>Someday</day></skipDays>` +
+		`</channel></rss>`)
+	feed, err := NewFeedFromBytes(data)
+	require.NoError(t, err)
+	source, ok := feed.FeedSource.(*rss.RSS)
+	require.True(t, ok)
+
+	assert.Equal(t, 90*time.Minute, source.GetTTL())
+	assert.Equal(t, []int{0, 1}, source.GetSkipHours())
+	assert.Equal(t, []time.Weekday{time.Saturday, time.Sunday}, source.GetSkipDays())
+
+	monday := time.Date(2026, time.March, 2, 0, 30, 0, 0, time.UTC)
+	assert.True(t, source.ShouldSkip(monday))
+	assert.False(t, source.ShouldSkip(monday.Add(2*time.Hour)))
+	assert.True(t, source.ShouldSkip(monday.AddDate(0, 0, 5).Add(12*time.Hour)))
+	// Skip hours are in GMT, regardless of the location of the time.
+	assert.True(t, source.ShouldSkip(monday.In(time.FixedZone("AEST", 10*60*60))))
+
+	hints := feed.GetUpdateHints()
+	assert.Equal(t, 90*time.Minute, hints.TTL)
+	assert.Equal(t, source.GetSkipHours(), hints.SkipHours)
+	assert.Equal(t, source.GetSkipDays(), hints.SkipDays)
+	assert.Equal(t, source.ShouldSkip(monday), hints.ShouldSkip(monday))
+
+	// The TTL is longer than the default interval, and the next update falls in a skipped hour.
+	last := time.Date(2026, time.March, 2, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.March, 3, 2, 0, 0, 0, time.UTC), hints.NextUpdate(last))
+	// Friday night moves past the weekend.
+	friday := time.Date(2026, time.March, 6, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.March, 9, 2, 0, 0, 0, time.UTC), hints.NextUpdate(friday))
+}
+
+func TestUpdateHintsWithoutSkips(t *testing.T) {
+	feed := newTestFeed(t, types.SourceTypeAtom)
+	hints := feed.GetUpdateHints()
+	assert.Equal(t, feed.GetUpdateInterval(), hints.Interval)
+	assert.Zero(t, hints.TTL)
+	assert.Empty(t, hints.SkipHours)
+	assert.Empty(t, hints.SkipDays)
+
+	last := time.Date(2026, time.March, 2, 0, 30, 0, 0, time.UTC)
+	assert.Equal(t, last.Add(hints.Interval), hints.NextUpdate(last))
+
+	// Every hour skipped falls back to the interval.
+	hints.SkipHours = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23}
+	assert.Equal(t, last.Add(hints.Interval), hints.NextUpdate(last))
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/extensions/media"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// SortField is a field by which items can be sorted with SortItems.
+type SortField int
+
+const (
+	// SortByPublished sorts items by their published date.
+	SortByPublished SortField = iota
+	// SortByUpdated sorts items by their updated date, or their published date if they have not been updated.
+	SortByUpdated
+	// SortByTitle sorts items by their title, ignoring case.
+	SortByTitle
+	// SortByViews sorts items by the view count in their <media:statistics>, such as the videos of a YouTube feed.
+	SortByViews
+)
+
+// mediaCommunitySource is an ItemSource with a <media:community> (i.e., an RSS item or Atom entry).
+type mediaCommunitySource interface {
+	GetMediaCommunity() *media.MediaCommunity
+}
+
+// GetMediaCommunity returns the <media:community> of the Item (if any), with its star rating and statistics such as the
+// view count. Only RSS items and Atom entries can have one; for other formats, nil is returned.
+func (i *Item) GetMediaCommunity() *media.MediaCommunity {
+	if source, ok := i.ItemSource.(mediaCommunitySource); ok {
+		return source.GetMediaCommunity()
+	}
+	return nil
+}
+
+// GetItemsSince returns the items of the Feed dated at or after the given time. See GetItemsBetween for how the date of
+// an item is determined.
+func (f *Feed) GetItemsSince(since time.Time) []Item {
+	var items []Item
+	for item := range f.ItemsSeq() {
+		if date := itemDate(&item); date != nil && !date.Before(since) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// GetItemsBetween returns the items of the Feed dated within the half-open window [from, to), i.e., at or after from
+// and before to. The date of an item is its published date or, if it has none, its updated date. Items without a date
+// are never returned. Dates that are the zero time or the Unix epoch, which some feeds (and parsers) use as a sentinel
+// for a missing date, are treated as missing.
+func (f *Feed) GetItemsBetween(from, to time.Time) []Item {
+	var items []Item
+	for item := range f.ItemsSeq() {
+		if date := itemDate(&item); date != nil && !date.Before(from) && date.Before(to) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// itemDate returns the date of the given item, as described by GetItemsBetween, or nil if it has no date.
+func itemDate(item *Item) *time.Time {
+	if date := item.GetPublishedDate(); isValidDate(date) {
+		return date
+	}
+	if date := item.GetUpdatedDate(); isValidDate(date) {
+		return date
+	}
+	return nil
+}
+
+// isValidDate reports whether the given date is set and is not a sentinel for a missing date.
+func isValidDate(date *time.Time) bool {
+	return date != nil && !date.IsZero() && !date.Equal(time.Unix(0, 0))
+}
+
+// SortItems sorts the given items in place by the given field, in ascending order, or descending order if desc is true.
+// When sorting by date, items without a date are always sorted last, as are items without <media:statistics> when
+// sorting by views. The sort is stable, so items that compare equal keep their original order.
+func SortItems(items []Item, by SortField, desc bool) {
+	slices.SortStableFunc(items, func(a, b Item) int {
+		var order int
+		switch by {
+		case SortByTitle:
+			order = cmp.Compare(strings.ToLower(a.GetTitle()), strings.ToLower(b.GetTitle()))
+		case SortByViews:
+			statsA, statsB := a.GetMediaCommunity().GetStatistics(), b.GetMediaCommunity().GetStatistics()
+			switch {
+			case statsA == nil && statsB == nil:
+				return 0
+			case statsA == nil:
+				return 1
+			case statsB == nil:
+				return -1
+			}
+			order = cmp.Compare(statsA.Views, statsB.Views)
+		default:
+			dateA, dateB := sortDate(&a, by), sortDate(&b, by)
+			switch {
+			case dateA == nil && dateB == nil:
+				return 0
+			case dateA == nil:
+				return 1
+			case dateB == nil:
+				return -1
+			}
+			order = dateA.Compare(*dateB)
+		}
+		if desc {
+			return -order
+		}
+		return order
+	})
+}
+
+// sortDate returns the date of the given item to sort by for the given field, or nil if it has no date.
+func sortDate(item *Item, by SortField) *time.Time {
+	if by == SortByUpdated {
+		if date := item.GetUpdatedDate(); isValidDate(date) {
+			return date
+		}
+	}
+	if date := item.GetPublishedDate(); isValidDate(date) {
+		return date
+	}
+	return nil
+}
+
+// FilterItems returns the items for which the given predicate returns true. The given items are not modified.
+func FilterItems(items []Item, predicate func(Item) bool) []Item {
+	var filtered []Item
+	for item := range slices.Values(items) {
+		if predicate(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// HasEnclosure is a predicate for FilterItems that matches items with an enclosure, such as podcast episodes. This is
+// an <enclosure> for RSS, a link with an "enclosure" relation for Atom and an attachment for JSONFeed.
+func HasEnclosure(item Item) bool {
+	switch source := item.ItemSource.(type) {
+	case *rss.Item:
+		return len(source.Enclosures) > 0
+	case *atom.Entry:
+		return slices.ContainsFunc(source.Links, func(link atom.Link) bool {
+			return link.Rel == atom.LinkRelEnclosure
+		})
+	case *jsonfeed.Item:
+		return len(source.Attachments) > 0
+	default:
+		return false
+	}
+}
+
+// MatchesCategory returns a predicate for FilterItems that matches items with the given category, ignoring case.
+func MatchesCategory(category string) func(Item) bool {
+	return func(item Item) bool {
+		return slices.ContainsFunc(item.GetCategories(), func(itemCategory string) bool {
+			return strings.EqualFold(itemCategory, category)
+		})
+	}
+}
+
+// TitleContains returns a predicate for FilterItems that matches items whose title contains the given text, ignoring
+// case.
+func TitleContains(text string) func(Item) bool {
+	text = strings.ToLower(text)
+	return func(item Item) bool {
+		return strings.Contains(strings.ToLower(item.GetTitle()), text)
+	}
+}
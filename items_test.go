@@ -0,0 +1,118 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// newDatedFeed creates a Feed with items published at the given times, titled by their index.
+func newDatedFeed(dates ...time.Time) *Feed {
+	source := &rss.RSS{Channel: rss.Channel{Title: "Test"}}
+	for idx, date := range dates {
+		source.Channel.Items = append(source.Channel.Items,
+			*rss.NewItem(rss.WithItemTitle(string(rune('A'+idx))), rss.WithItemPublishedDate(date)))
+	}
+	source.Channel.Items = append(source.Channel.Items, rss.Item{Title: "Undated"})
+	return NewFeedFromSource(source)
+}
+
+// itemTitles returns the titles of the given items.
+func itemTitles(items []Item) []string {
+	titles := make([]string, 0, len(items))
+	for item := range slices.Values(items) {
+		titles = append(titles, item.GetTitle())
+	}
+	return titles
+}
+
+func TestFeedGetItemsSince(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	feed := newDatedFeed(now.Add(-48*time.Hour), now, now.Add(time.Hour), time.Unix(0, 0))
+
+	assert.Equal(t, []string{"B", "C"}, itemTitles(feed.GetItemsSince(now)))
+	assert.Equal(t, []string{"A", "B", "C"}, itemTitles(feed.GetItemsSince(time.Time{})))
+	assert.Empty(t, feed.GetItemsSince(now.Add(2*time.Hour)))
+}
+
+func TestFeedGetItemsBetween(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	feed := newDatedFeed(now.Add(-48*time.Hour), now, now.Add(time.Hour))
+
+	assert.Equal(t, []string{"B"}, itemTitles(feed.GetItemsBetween(now, now.Add(time.Hour))))
+	assert.Equal(t, []string{"A", "B"}, itemTitles(feed.GetItemsBetween(now.Add(-72*time.Hour), now.Add(time.Minute))))
+	assert.Empty(t, feed.GetItemsBetween(now.Add(time.Hour), now))
+}
+
+func TestSortItems(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	feed := newDatedFeed(now, now.Add(-time.Hour), now.Add(time.Hour))
+
+	items := feed.GetItems()
+	SortItems(items, SortByPublished, false)
+	assert.Equal(t, []string{"B", "A", "C", "Undated"}, itemTitles(items))
+	SortItems(items, SortByPublished, true)
+	assert.Equal(t, []string{"C", "A", "B", "Undated"}, itemTitles(items))
+	SortItems(items, SortByUpdated, true)
+	assert.Equal(t, []string{"C", "A", "B", "Undated"}, itemTitles(items))
+	SortItems(items, SortByTitle, true)
+	assert.Equal(t, []string{"Undated", "C", "B", "A"}, itemTitles(items))
+}
+
+func TestSortItemsByViews(t *testing.T) {
+	entry := func(title, community string) string {
+		return `<entry><title>` + title + `</title><media:group><media:title>` + title + `</media:title>` + community +
+			`</media:group></entry>`
+	}
+	data := `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">` +
+		`<title>Videos</title>` +
+		entry("A", `<media:community><media:statistics views="10"/></media:community>`) +
+		entry("B", "") +
+		entry("C", `<media:community><media:starRating count="3" average="4.50" min="1" max="5"/>`+
+			`<media:statistics views="200" favorites="5"/></media:community>`) +
+		`</feed>`
+	feed, err := NewFeedFromBytes([]byte(data))
+	require.NoError(t, err)
+
+	items := feed.GetItems()
+	community := items[2].GetMediaCommunity()
+	require.NotNil(t, community)
+	assert.Equal(t, 200, community.GetViews())
+	assert.Equal(t, 5, community.GetFavorites())
+	require.NotNil(t, community.GetStarRating())
+	assert.InDelta(t, 4.5, community.GetStarRating().Average, 0.001)
+	assert.Nil(t, items[1].GetMediaCommunity())
+	assert.Zero(t, items[1].GetMediaCommunity().GetViews())
+
+	SortItems(items, SortByViews, true)
+	assert.Equal(t, []string{"C", "A", "B"}, itemTitles(items))
+	SortItems(items, SortByViews, false)
+	assert.Equal(t, []string{"A", "C", "B"}, itemTitles(items))
+}
+
+func TestFilterItems(t *testing.T) {
+	source := &rss.RSS{
+		Channel: rss.Channel{
+			Title: "Test",
+			Items: []rss.Item{
+				{Title: "Episode One", Enclosures: []rss.Enclosure{{URL: "https://example.com/1.mp3", Type: "audio/mpeg"}}},
+				{Title: "Show Notes", Categories: []rss.Category{{Value: "News"}}},
+				{Title: "Episode Two", Categories: []rss.Category{{Value: "news"}}},
+			},
+		},
+	}
+	items := NewFeedFromSource(source).GetItems()
+
+	assert.Equal(t, []string{"Episode One"}, itemTitles(FilterItems(items, HasEnclosure)))
+	assert.Equal(t, []string{"Show Notes", "Episode Two"}, itemTitles(FilterItems(items, MatchesCategory("NEWS"))))
+	assert.Equal(t, []string{"Episode One", "Episode Two"}, itemTitles(FilterItems(items, TitleContains("episode"))))
+	assert.Empty(t, FilterItems(items, TitleContains("missing")))
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package jsonfeed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// ErrExtension indicates an extension object could not be read.
+var ErrExtension = errors.New("unable to read extension")
+
+// Extensions are the custom extension objects of a Feed or Item, such as "_itunes", keyed by their name (including the
+// leading underscore). Values are the raw JSON of each object, exactly as it appeared in the feed.
+type Extensions map[string]json.RawMessage
+
+// GetExtensions returns the custom extension objects of the Feed (i.e., its top-level members whose names start with
+// an underscore). The returned map is a copy, so modifying it does not change the Feed.
+func (a *Feed) GetExtensions() Extensions {
+	return newExtensions(a.AdditionalProperties)
+}
+
+// GetExtensions returns the custom extension objects of the Item (i.e., its members whose names start with an
+// underscore). The returned map is a copy, so modifying it does not change the Item.
+func (a *Item) GetExtensions() Extensions {
+	return newExtensions(a.AdditionalProperties)
+}
+
+// newExtensions returns the extension objects among the given additional properties of a Feed or Item.
+func newExtensions(properties map[string]json.RawMessage) Extensions {
+	var extensions Extensions
+	for name, value := range maps.All(properties) {
+		if !strings.HasPrefix(name, "_") {
+			continue
+		}
+		if extensions == nil {
+			extensions = make(Extensions)
+		}
+		extensions[name] = value
+	}
+	return extensions
+}
+
+// Has reports whether the extension object with the given name is present. The leading underscore of the name is
+// optional.
+func (e Extensions) Has(name string) bool {
+	_, found := e[extensionName(name)]
+	return found
+}
+
+// Decode unmarshals the extension object with the given name into the value pointed to by v. The leading underscore of
+// the name is optional. If the extension is not present, v is left unchanged and false is returned.
+func (e Extensions) Decode(name string, v any) (bool, error) {
+	value, found := e[extensionName(name)]
+	if !found {
+		return false, nil
+	}
+	if err := json.Unmarshal(value, v); err != nil {
+		return true, fmt.Errorf("%w: %s: %w", ErrExtension, extensionName(name), err)
+	}
+	return true, nil
+}
+
+// GetExtension returns the extension object with the given name in the given Extensions, unmarshaled into a value of
+// type T, such as a struct describing a publisher-specific extension. The leading underscore of the name is optional.
+// If the extension is not present, the zero value of T and false are returned.
+func GetExtension[T any](extensions Extensions, name string) (T, bool, error) {
+	var value T
+	found, err := extensions.Decode(name, &value)
+	return value, found, err
+}
+
+// extensionName returns the given extension name with its leading underscore.
+func extensionName(name string) string {
+	return "_" + strings.TrimPrefix(name, "_")
+}
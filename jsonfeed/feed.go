@@ -4,6 +4,8 @@
 package jsonfeed
 
 import (
+	"fmt"
+	"iter"
 	"slices"
 	"time"
 
@@ -19,6 +21,7 @@ var (
 )
 
 var _ types.FeedSource = (*Feed)(nil)
+var _ types.HasIcon = (*Feed)(nil)
 
 // GetTitle retrieves the title of the Feed.
 func (f *Feed) GetTitle() string {
@@ -54,6 +57,38 @@ func (f *Feed) GetLink() string {
 	return ""
 }
 
+// SetTitle sets the title of the Feed.
+func (f *Feed) SetTitle(title string) {
+	f.Title = title
+}
+
+// SetDescription sets the description of the Feed.
+func (f *Feed) SetDescription(description string) {
+	f.Description = &description
+}
+
+// SetLink sets the home_page_url of the Feed, the link to the website associated with the JSONFeed.
+func (f *Feed) SetLink(link string) {
+	f.HomePageURL = &link
+}
+
+// SetPublishedDate does nothing, as a JSONFeed has no published date of its own; it is derived from its items, see
+// GetPublishedDate.
+func (f *Feed) SetPublishedDate(_ time.Time) {}
+
+// AddCategory does nothing, as a JSONFeed has no categories of its own.
+func (f *Feed) AddCategory(_ string) {}
+
+// AddItem appends the given item, which must be an *Item, to the Feed.
+func (f *Feed) AddItem(item types.ItemSource) error {
+	jsonItem, ok := item.(*Item)
+	if !ok {
+		return fmt.Errorf("%w: cannot add %T to a JSONFeed", types.ErrIncompatibleItem, item)
+	}
+	f.Items = append(f.Items, *jsonItem)
+	return nil
+}
+
 // GetAuthors retrieves the authors (if any) of the Feed. This will be the list of values from any <author> and
 // <dc:creator> elements.
 func (f *Feed) GetAuthors() []string {
@@ -110,6 +145,24 @@ func (f *Feed) GetImage() *types.ImageInfo {
 	return nil
 }
 
+// GetIcon retrieves the icon (if any) for the Feed. It will retrieve the favicon, which is meant for source lists, or
+// the icon if there is no favicon.
+func (f *Feed) GetIcon() *types.ImageInfo {
+	var url string
+	switch {
+	case f.Favicon != nil:
+		url = *f.Favicon
+	case f.Icon != nil:
+		url = *f.Icon
+	}
+	if url != "" {
+		return &types.ImageInfo{
+			URL: url,
+		}
+	}
+	return nil
+}
+
 // SetImage sets an image for the Feed. This will set the icon value.
 func (f *Feed) SetImage(image *types.ImageInfo) {
 	url := image.GetURL()
@@ -121,8 +174,8 @@ func (f *Feed) SetImage(image *types.ImageInfo) {
 func (f *Feed) GetPublishedDate() *time.Time {
 	published := time.Unix(0, 0)
 	for item := range slices.Values(f.Items) {
-		if item.GetPublishedDate().After(published) {
-			published = *item.GetPublishedDate()
+		if date := item.GetPublishedDate(); date != nil && date.After(published) {
+			published = *date
 		}
 	}
 	if !published.IsZero() {
@@ -136,8 +189,8 @@ func (f *Feed) GetPublishedDate() *time.Time {
 func (f *Feed) GetUpdatedDate() *time.Time {
 	modified := time.Unix(0, 0)
 	for item := range slices.Values(f.Items) {
-		if item.GetUpdatedDate().After(modified) {
-			modified = *item.GetUpdatedDate()
+		if date := item.GetUpdatedDate(); date != nil && date.After(modified) {
+			modified = *date
 		}
 	}
 	if !modified.IsZero() {
@@ -163,15 +216,27 @@ func (f *Feed) GetUpdateInterval() time.Duration {
 	return DefaultFeedUpdateInterval
 }
 
-// GetItems returns a slice of Entry values for the Feed.
+// GetItems returns a slice of Item values for the Feed. The values point to the items of the Feed, so any changes made
+// to them are reflected in the Feed.
 func (f *Feed) GetItems() []types.ItemSource {
 	items := make([]types.ItemSource, 0, len(f.Items))
-	for item := range slices.Values(f.Items) {
-		items = append(items, &item)
+	for idx := range f.Items {
+		items = append(items, &f.Items[idx])
 	}
 	return items
 }
 
+// ItemsSeq returns an iterator over the Item values of the Feed. Unlike GetItems, it does not allocate a slice.
+func (f *Feed) ItemsSeq() iter.Seq[types.ItemSource] {
+	return func(yield func(types.ItemSource) bool) {
+		for idx := range f.Items {
+			if !yield(&f.Items[idx]) {
+				return
+			}
+		}
+	}
+}
+
 // Validate applies custom validation to an feed.
 func (f *Feed) Validate() error {
 	return validation.ValidateStruct(f)
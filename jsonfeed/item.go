@@ -104,6 +104,35 @@ func (i *Item) GetPublishedDate() *time.Time {
 	return nil
 }
 
+// SetTitle sets the title of the Item.
+func (i *Item) SetTitle(title string) {
+	i.Title = &title
+}
+
+// SetDescription sets the summary of the Item.
+func (i *Item) SetDescription(description string) {
+	i.Summary = &description
+}
+
+// SetLink sets the url of the Item.
+func (i *Item) SetLink(link string) {
+	i.URL = &link
+}
+
+// SetPublishedDate sets the date_published of the Item. A zero date removes it.
+func (i *Item) SetPublishedDate(date time.Time) {
+	if date.IsZero() {
+		i.DatePublished = nil
+		return
+	}
+	i.DatePublished = new(date.Format(time.RFC3339))
+}
+
+// AddCategory adds a tag to the Item.
+func (i *Item) AddCategory(category string) {
+	i.Tags = append(i.Tags, category)
+}
+
 // GetUpdatedDate returns the updated (modified) date of the Item.
 func (i *Item) GetUpdatedDate() *time.Time {
 	if i.DateModified != nil {
@@ -131,3 +160,12 @@ func (i *Item) GetContent() *string {
 	}
 	return nil
 }
+
+// GetDuration returns how long the Attachment takes to listen to or watch, from its duration_in_seconds, or zero if it
+// is not known.
+func (a *Attachment) GetDuration() time.Duration {
+	if a.DurationInSeconds == nil || *a.DurationInSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(*a.DurationInSeconds) * time.Second
+}
@@ -85,8 +85,8 @@ type Feed struct {
 	UserComment *string `json:"user_comment,omitempty"`
 
 	// Version is the URL of the version of the format the feed uses.
-	Version              string                 `json:"version" validate:"required,url"`
-	AdditionalProperties map[string]interface{} `json:"-"`
+	Version              string                     `json:"version" validate:"required,url"`
+	AdditionalProperties map[string]json.RawMessage `json:"-"`
 }
 
 // Hub describes an endpoint that can be used to subscribe to real-time notifications.
@@ -146,8 +146,8 @@ type Item struct {
 	Title *string `json:"title,omitempty"`
 
 	// URL is the URL of the resource described by the item. It’s the permalink.
-	URL                  *string                `json:"url,omitempty" validate:"omitempty,url"`
-	AdditionalProperties map[string]interface{} `json:"-"`
+	URL                  *string                    `json:"url,omitempty" validate:"omitempty,url"`
+	AdditionalProperties map[string]json.RawMessage `json:"-"`
 }
 
 // Getter for additional properties for Attachment. Returns the specified
@@ -376,7 +376,7 @@ func (a Author) MarshalJSON() ([]byte, error) {
 
 // Getter for additional properties for Feed. Returns the specified
 // element and whether it was found
-func (a Feed) Get(fieldName string) (value interface{}, found bool) {
+func (a Feed) Get(fieldName string) (value json.RawMessage, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
@@ -384,9 +384,9 @@ func (a Feed) Get(fieldName string) (value interface{}, found bool) {
 }
 
 // Setter for additional properties for Feed
-func (a *Feed) Set(fieldName string, value interface{}) {
+func (a *Feed) Set(fieldName string, value json.RawMessage) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+		a.AdditionalProperties = make(map[string]json.RawMessage)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
@@ -520,9 +520,9 @@ func (a *Feed) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		a.AdditionalProperties = make(map[string]json.RawMessage)
 		for fieldName, fieldBuf := range object {
-			var fieldVal interface{}
+			var fieldVal json.RawMessage
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -650,7 +650,7 @@ func (a Feed) MarshalJSON() ([]byte, error) {
 
 // Getter for additional properties for Item. Returns the specified
 // element and whether it was found
-func (a Item) Get(fieldName string) (value interface{}, found bool) {
+func (a Item) Get(fieldName string) (value json.RawMessage, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
@@ -658,9 +658,9 @@ func (a Item) Get(fieldName string) (value interface{}, found bool) {
 }
 
 // Setter for additional properties for Item
-func (a *Item) Set(fieldName string, value interface{}) {
+func (a *Item) Set(fieldName string, value json.RawMessage) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+		a.AdditionalProperties = make(map[string]json.RawMessage)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
@@ -802,9 +802,9 @@ func (a *Item) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		a.AdditionalProperties = make(map[string]json.RawMessage)
 		for fieldName, fieldBuf := range object {
-			var fieldVal interface{}
+			var fieldVal json.RawMessage
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -0,0 +1,56 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+)
+
+func TestJSONFeedExtensions(t *testing.T) {
+	data := []byte(`{"version":"https://jsonfeed.org/version/1.1","title":"Podcast",` +
+		`"_itunes":{"about":"https://example.com/itunes","explicit":false,"owner":"Example"},"unknown":1,` +
+		`"items":[{"id":"1","_blue_shed":{"about":"https://blueshed-podcasts.com/","id":12345678901234567890}}]}`)
+	feed, err := NewFeedFromBytes(data)
+	require.NoError(t, err)
+	source, ok := feed.FeedSource.(*jsonfeed.Feed)
+	require.True(t, ok)
+
+	extensions := source.GetExtensions()
+	assert.Len(t, extensions, 1)
+	assert.True(t, extensions.Has("_itunes"))
+	assert.True(t, extensions.Has("itunes"))
+	assert.False(t, extensions.Has("unknown"))
+
+	type itunes struct {
+		About    string `json:"about"`
+		Explicit bool   `json:"explicit"`
+		Owner    string `json:"owner"`
+	}
+	value, found, err := jsonfeed.GetExtension[itunes](extensions, "itunes")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, itunes{About: "https://example.com/itunes", Owner: "Example"}, value)
+
+	_, found, err = jsonfeed.GetExtension[itunes](extensions, "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+	_, _, err = jsonfeed.GetExtension[string](extensions, "itunes")
+	require.ErrorIs(t, err, jsonfeed.ErrExtension)
+
+	// Item extensions are kept exactly as they appeared, including numbers too large for a float64.
+	item := source.Items[0].GetExtensions()
+	assert.JSONEq(t, `{"about":"https://blueshed-podcasts.com/","id":12345678901234567890}`, string(item["_blue_shed"]))
+
+	// Extensions survive re-encoding the feed.
+	encoded, err := json.Marshal(source)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `12345678901234567890`)
+	assert.Contains(t, string(encoded), `"_itunes"`)
+}
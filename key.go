@@ -0,0 +1,92 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// Prefixes of the keys returned by Item.Key, indicating how the key was computed.
+const (
+	keyPrefixID   = "id:"
+	keyPrefixLink = "link:"
+	keyPrefixHash = "hash:"
+)
+
+// Key returns a stable identifier for the Item, for recognising the same item across fetches of a feed, and across
+// different feeds. It is used wherever the library needs to match items, such as by Feed.Refresh. The key is computed
+// from the first of the following that the item has:
+//
+//  1. Its ID (the <guid> of an RSS item, the <id> of an Atom entry, etc.), with surrounding whitespace removed. If the
+//     ID is a HTTP(S) URL, it is canonicalized as for the link below. The key is prefixed with "id:".
+//  2. Its link, canonicalized by lower-casing the scheme and host, removing any default port, fragment and
+//     tracking parameters (see StripTrackingParams) and sorting the remaining query parameters. The key is prefixed
+//     with "link:".
+//  3. A SHA-256 hash of its title, with surrounding whitespace removed, and its published date, in UTC and RFC 3339
+//     format, separated by a newline. The key is the prefix "hash:" followed by the hex encoding of the first 16 bytes
+//     of the hash.
+//
+// The prefix ensures keys computed in different ways never collide.
+func (i *Item) Key() string {
+	return itemKey(i.ItemSource)
+}
+
+// itemKey computes the key of the given item, as described by Item.Key.
+func itemKey(item types.ItemSource) string {
+	if id := strings.TrimSpace(item.GetID()); id != "" {
+		if isHTTPURL(id) {
+			return keyPrefixID + canonicalizeURL(id)
+		}
+		return keyPrefixID + id
+	}
+	if link := strings.TrimSpace(item.GetLink()); link != "" {
+		return keyPrefixLink + canonicalizeURL(link)
+	}
+	var published string
+	if date := item.GetPublishedDate(); date != nil {
+		published = date.UTC().Format(time.RFC3339)
+	}
+	hash := sha256.Sum256([]byte(strings.TrimSpace(item.GetTitle()) + "\n" + published))
+	return keyPrefixHash + hex.EncodeToString(hash[:16])
+}
+
+// isHTTPURL reports whether the given value is an absolute HTTP(S) URL.
+func isHTTPURL(value string) bool {
+	lower := strings.ToLower(value)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// canonicalizeURL returns the canonical form of the given URL, as described by Item.Key. Values that cannot be parsed
+// as a URL are returned unchanged.
+func canonicalizeURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return value
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		// The port is trimmed from the host, rather than using the hostname, to keep the brackets of an IPv6 address.
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.Fragment, u.RawFragment = "", ""
+	query := u.Query()
+	for param := range query {
+		if isTrackingParam(param) {
+			query.Del(param)
+		}
+	}
+	// Encode sorts the parameters by key.
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func TestItemKey(t *testing.T) {
+	tests := []struct {
+		name string
+		item *rss.Item
+		want string
+	}{
+		{
+			name: "id",
+			item: &rss.Item{GUID: rss.NewGUID("  tag:example.com,2026:1 ", false), Link: "https://example.com/1"},
+			want: "id:tag:example.com,2026:1",
+		},
+		{
+			name: "url id",
+			item: &rss.Item{GUID: rss.NewGUID("HTTPS://Example.com:443/1#top", true)},
+			want: "id:https://example.com/1",
+		},
+		{
+			name: "link",
+			item: &rss.Item{Link: "http://Example.COM:80?b=2&utm_source=feed&a=1#comments", Title: "Ignored"},
+			want: "link:http://example.com/?a=1&b=2",
+		},
+		{
+			name: "ipv6 link",
+			item: &rss.Item{Link: "http://[::1]:80/x"},
+			want: "link:http://[::1]/x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &Item{ItemSource: tt.item}
+			assert.Equal(t, tt.want, item.Key())
+		})
+	}
+}
+
+func TestItemKeyHash(t *testing.T) {
+	published := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	key := func(title string, date time.Time) string {
+		return (&Item{ItemSource: &rss.Item{Title: title, PubDate: rss.NewTimestamp(date)}}).Key()
+	}
+
+	assert.True(t, strings.HasPrefix(key("Untitled", published), "hash:"))
+	assert.Len(t, key("Untitled", published), len("hash:")+32)
+	assert.Equal(t, key("Untitled", published), key(" Untitled\n", published.In(time.FixedZone("AEST", 10*3600))))
+	assert.NotEqual(t, key("Untitled", published), key("Untitled", published.Add(time.Hour)))
+	assert.NotEqual(t, key("Untitled", published), key("Other", published))
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// minDetectionLetters is the minimum number of letters required in a text before attempting to detect its
+	// language. Anything shorter does not contain enough trigrams for a meaningful result.
+	minDetectionLetters = 20
+	// minDetectionMargin is how much better the best scoring language must be than the runner-up for the result to
+	// be trusted.
+	minDetectionMargin = 1.15
+)
+
+// languageProfiles contains the most frequent trigrams, in descending order of frequency, for each language that can
+// be detected. Word boundaries are represented by an underscore. The profiles are intentionally small; they are only
+// meant to route items between common languages, not to be an exhaustive language identification tool.
+var languageProfiles = map[string][]string{
+	"en": {
+		"_th", "the", "he_", "_an", "nd_", "and", "ed_", "_to", "to_", "ing", "ng_", "_of", "of_", "_in", "in_",
+		"ion", "tio", "is_", "_is", "er_", "on_", "es_", "re_", "_a_", "for", "_fo", "or_", "ent", "hat", "tha",
+		"_wa", "as_", "it_", "_it", "ly_", "_be", "ter", "_wh", "_co", "all",
+	},
+	"fr": {
+		"_de", "de_", "es_", "_le", "le_", "ent", "_la", "la_", "les", "nt_", "ion", "on_", "_et", "et_", "re_",
+		"que", "ue_", "_qu", "des", "_pa", "_po", "our", "par", "ait", "_un", "une", "_en", "en_", "men", "eme",
+		"_ce", "ur_", "ans", "dan", "_da", "ns_", "_il", "est", "pas", "eur",
+	},
+	"de": {
+		"_de", "der", "er_", "en_", "die", "_di", "ie_", "ich", "sch", "ein", "_ei", "und", "_un", "nd_", "che",
+		"ch_", "cht", "den", "_da", "_zu", "_ge", "gen", "ung", "ng_", "ine", "in_", "_in", "ter", "ten", "_be",
+		"ist", "_is", "st_", "das", "_ve", "ver", "auf", "sie", "nic", "mit",
+	},
+	"es": {
+		"_de", "de_", "_la", "la_", "os_", "_el", "el_", "es_", "_qu", "que", "ue_", "_en", "en_", "ent", "as_",
+		"_lo", "los", "ado", "_co", "con", "_se", "_es", "aci", "ión", "ón_", "_un", "una", "_po", "por", "or_",
+		"ra_", "do_", "_pa", "par", "_su", "las", "_al", "nte", "est", "_no",
+	},
+	"it": {
+		"_di", "di_", "_il", "il_", "_la", "la_", "_de", "del", "ell", "lla", "_ch", "che", "he_", "to_", "_co",
+		"con", "re_", "_pe", "per", "er_", "_in", "ent", "one", "ne_", "_un", "una", "are", "zio", "_al", "gli",
+		"_gl", "li_", "ato", "_so", "ono", "no_", "_si", "non", "nte", "_e_",
+	},
+	"nl": {
+		"_de", "de_", "en_", "_he", "het", "et_", "_ee", "een", "van", "_va", "an_", "_en", "_in", "in_", "_is",
+		"is_", "_da", "dat", "at_", "_ge", "gen", "_te", "te_", "_op", "op_", "_me", "met", "_vo", "voo", "oor",
+		"or_", "ver", "_ve", "aar", "ijk", "_zi", "_ni", "nie", "iet", "ook",
+	},
+	"pt": {
+		"_de", "de_", "_qu", "que", "ue_", "_a_", "_o_", "os_", "_do", "do_", "da_", "_da", "_e_", "_co", "com",
+		"_em", "em_", "ent", "_se", "ção", "ão_", "ões", "_pa", "par", "ara", "_um", "uma", "_no", "_na", "_po",
+		"por", "nte", "_es", "est", "ado", "dos", "_ma", "ais", "_nã", "não",
+	},
+}
+
+// detectLanguage attempts to detect the language of the given text by comparing its trigrams against a set of known
+// language profiles. It returns the ISO 639-1 code of the detected language, or an empty string if the text is too
+// short or no language could be detected with reasonable confidence.
+func detectLanguage(text string) string {
+	trigrams, letters := extractTrigrams(text)
+	if letters < minDetectionLetters {
+		return ""
+	}
+
+	var (
+		best, runnerUp float64
+		detected       string
+	)
+	for lang, profile := range languageProfiles {
+		var score float64
+		for rank, trigram := range profile {
+			score += float64(trigrams[trigram] * (len(profile) - rank))
+		}
+		switch {
+		case score > best:
+			runnerUp = best
+			best = score
+			detected = lang
+		case score > runnerUp:
+			runnerUp = score
+		}
+	}
+	if best == 0 || best < runnerUp*minDetectionMargin {
+		return ""
+	}
+	return detected
+}
+
+// extractTrigrams splits the text into words and counts the trigrams of each word, padded with an underscore to mark
+// word boundaries. It also returns the total number of letters seen.
+func extractTrigrams(text string) (map[string]int, int) {
+	trigrams := make(map[string]int)
+	var letters int
+	for word := range strings.FieldsFuncSeq(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		runes := []rune("_" + word + "_")
+		letters += len(runes) - 2
+		for idx := 0; idx+3 <= len(runes); idx++ {
+			trigrams[string(runes[idx:idx+3])]++
+		}
+	}
+	return trigrams, letters
+}
+
+// detectItemLanguage attempts to detect the language of the given item from its title, description and content. Any
+// markup is stripped before detection.
+func detectItemLanguage(title, description string, content *string) string {
+	text := []string{title, description}
+	if content != nil {
+		text = append(text, *content)
+	}
+	return detectLanguage(plainText(strings.Join(text, " ")))
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/extensions/dc"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func TestItemGetLanguage(t *testing.T) {
+	newSource := func(lang *string, items ...rss.Item) *rss.RSS {
+		return &rss.RSS{Channel: rss.Channel{Title: "Test", Language: lang, Items: items}}
+	}
+	english := rss.Item{
+		Title: "The weather is improving",
+		Description: rss.NewItemDescription(
+			"The forecast for the rest of the week is that it will be warm and sunny in the north of the country.",
+			false,
+		),
+	}
+	french := rss.Item{
+		Title: "Le temps s'améliore",
+		Description: rss.NewItemDescription(
+			"Les prévisions pour le reste de la semaine annoncent que le temps sera chaud et ensoleillé dans le nord du pays.",
+			false,
+		),
+	}
+	german := rss.Item{
+		Title: "Das Wetter wird besser",
+		Description: rss.NewItemDescription(
+			"Die Vorhersage für den Rest der Woche ist, dass es im Norden des Landes warm und sonnig sein wird.",
+			false,
+		),
+	}
+	short := rss.Item{Title: "Hello"}
+	tagged := english
+	tagged.Language = &dc.Language{"en-GB"}
+
+	tests := []struct {
+		name    string
+		source  *rss.RSS
+		options []Option
+		want    []*string
+	}{
+		{
+			name:   "no detection",
+			source: newSource(nil, english, french),
+			want:   []*string{nil, nil},
+		},
+		{
+			name:    "detection",
+			source:  newSource(nil, english, french, german, short),
+			options: []Option{WithLanguageDetection()},
+			want:    []*string{new("en"), new("fr"), new("de"), nil},
+		},
+		{
+			name:    "feed language",
+			source:  newSource(new("fr"), english),
+			options: []Option{WithLanguageDetection()},
+			want:    []*string{new("fr")},
+		},
+		{
+			name:    "item language",
+			source:  newSource(new("fr"), tagged),
+			options: []Option{WithLanguageDetection()},
+			want:    []*string{new("en-GB")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := NewFeedFromSource(tt.source, tt.options...).GetItems()
+			require.Len(t, items, len(tt.want))
+			for idx, item := range items {
+				assert.Equal(t, tt.want[idx], item.GetLanguage())
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ErrFeedTooLarge indicates a feed exceeded the byte or token budget set for decoding it.
+var ErrFeedTooLarge = errors.New("feed too large")
+
+var (
+	// itemElements are the names of elements that represent an item in a feed.
+	itemElements = []string{"item", "entry"}
+	// itemParentElements are the names of elements that can contain items in a feed.
+	itemParentElements = []string{"channel", "feed", "RDF"}
+)
+
+// itemLimiter is an xml.TokenReader that stops reading tokens from the underlying xml.Decoder once a maximum number
+// of items have been read. When the limit is reached, it closes any open elements and then returns io.EOF, so that the
+// document decoded up to that point is still well-formed.
+type itemLimiter struct {
+	tokens    xml.TokenReader
+	open      []xml.Name
+	max       int
+	count     int
+	itemDepth int
+	done      bool
+}
+
+func newItemLimiter(tokens xml.TokenReader, maxItems int) *itemLimiter {
+	return &itemLimiter{
+		tokens:    tokens,
+		max:       maxItems,
+		itemDepth: -1,
+	}
+}
+
+// Token implements xml.TokenReader.
+func (l *itemLimiter) Token() (xml.Token, error) {
+	if l.done {
+		if len(l.open) == 0 {
+			return nil, io.EOF
+		}
+		name := l.open[len(l.open)-1]
+		l.open = l.open[:len(l.open)-1]
+		return xml.EndElement{Name: name}, nil
+	}
+
+	token, err := l.tokens.Token()
+	if err != nil {
+		return token, err
+	}
+	switch element := token.(type) {
+	case xml.StartElement:
+		if l.itemDepth < 0 && l.isItem(element) {
+			l.itemDepth = len(l.open)
+			l.count++
+		}
+		l.open = append(l.open, element.Name)
+	case xml.EndElement:
+		if len(l.open) > 0 {
+			l.open = l.open[:len(l.open)-1]
+		}
+		if len(l.open) == l.itemDepth {
+			l.itemDepth = -1
+			l.done = l.count >= l.max
+		}
+	}
+	return token, nil
+}
+
+// isItem reports whether the given element is an item of the feed.
+func (l *itemLimiter) isItem(element xml.StartElement) bool {
+	return len(l.open) > 0 &&
+		slices.Contains(itemElements, element.Name.Local) &&
+		slices.Contains(itemParentElements, l.open[len(l.open)-1].Local)
+}
+
+// tokenLimiter is an xml.TokenReader that returns an ErrFeedTooLarge error once more than a maximum number of tokens
+// have been read from the underlying xml.TokenReader.
+type tokenLimiter struct {
+	tokens xml.TokenReader
+	max    int
+	count  int
+}
+
+// Token implements xml.TokenReader.
+func (l *tokenLimiter) Token() (xml.Token, error) {
+	if l.count >= l.max {
+		return nil, fmt.Errorf("%w: exceeded budget of %d tokens", ErrFeedTooLarge, l.max)
+	}
+	l.count++
+	return l.tokens.Token()
+}
+
+// maxEmptyReads is the number of consecutive reads returning neither data nor an error after which a byteLimiter gives
+// up with an io.ErrNoProgress error.
+const maxEmptyReads = 100
+
+// byteLimiter is an io.Reader that returns an ErrFeedTooLarge error once more than a maximum number of bytes have been
+// read from the underlying io.Reader.
+type byteLimiter struct {
+	reader    io.Reader
+	remaining int64
+	max       int64
+}
+
+// Read implements io.Reader.
+func (l *byteLimiter) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Check whether there is any data beyond the budget, retrying reads that return neither data nor an error.
+		var probe [1]byte
+		for range maxEmptyReads {
+			if n, err := l.reader.Read(probe[:]); n > 0 {
+				return 0, fmt.Errorf("%w: exceeded budget of %d bytes", ErrFeedTooLarge, l.max)
+			} else if err != nil {
+				return 0, err
+			}
+		}
+		return 0, io.ErrNoProgress
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// limitReader wraps the given io.Reader to enforce any configured byte budget.
+func (c *config) limitReader(rd io.Reader) io.Reader {
+	if c.maxBytes <= 0 {
+		return rd
+	}
+	return &byteLimiter{reader: rd, remaining: c.maxBytes, max: c.maxBytes}
+}
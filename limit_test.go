@@ -0,0 +1,140 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// errReader returns an error on any read.
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read past limit")
+}
+
+// emptyReader returns neither data nor an error on the first empty reads, then reads from reader.
+type emptyReader struct {
+	reader io.Reader
+	empty  int
+}
+
+func (r *emptyReader) Read(p []byte) (int, error) {
+	if r.empty > 0 {
+		r.empty--
+		return 0, nil
+	}
+	return r.reader.Read(p)
+}
+
+func TestByteLimiterEmptyReads(t *testing.T) {
+	limiter := &byteLimiter{reader: &emptyReader{reader: strings.NewReader("x"), empty: 3}, max: 0}
+	_, err := limiter.Read(make([]byte, 1))
+	require.ErrorIs(t, err, ErrFeedTooLarge)
+
+	limiter = &byteLimiter{reader: &emptyReader{reader: strings.NewReader("x"), empty: maxEmptyReads}, max: 0}
+	_, err = limiter.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.ErrNoProgress)
+
+	limiter = &byteLimiter{reader: &emptyReader{reader: strings.NewReader(""), empty: 3}, max: 0}
+	_, err = limiter.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestWithMaxItems(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format, WithMaxItems(2))
+			titles := make([]string, 0, 2)
+			for item := range feed.ItemsSeq() {
+				titles = append(titles, item.GetTitle())
+			}
+			assert.Equal(t, []string{"First", "Second"}, titles)
+			assert.Equal(t, "Example Feed", feed.GetTitle())
+		})
+	}
+}
+
+func TestWithMaxItemsStopsReading(t *testing.T) {
+	rssData := `<?xml version="1.0"?>
+<rss version="2.0">
+  <channel>
+    <title>Example Feed</title>
+    <item><title>First</title></item>
+    <item><title>Second</title></item>`
+	atomData := `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example Feed</title>
+  <entry><title>First</title><id>urn:1</id></entry>`
+
+	feed, err := NewDecoder[*rss.RSS](io.MultiReader(strings.NewReader(rssData), errReader{}), WithMaxItems(2))
+	require.NoError(t, err)
+	assert.Len(t, feed.GetItems(), 2)
+
+	feed, err = NewDecoder[*atom.Feed](io.MultiReader(strings.NewReader(atomData), errReader{}), WithMaxItems(1))
+	require.NoError(t, err)
+	assert.Len(t, feed.GetItems(), 1)
+	assert.Equal(t, "Example Feed", feed.GetTitle())
+
+	// Without a limit, the reader error is returned.
+	_, err = NewDecoder[*rss.RSS](io.MultiReader(strings.NewReader(rssData), errReader{}))
+	require.ErrorIs(t, err, ErrParseBytes)
+}
+
+func TestDecodeItemsWithMaxItems(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			var titles []string
+			for item, err := range DecodeItems(strings.NewReader(data), format, WithMaxItems(2)) {
+				require.NoError(t, err)
+				titles = append(titles, item.GetTitle())
+			}
+			assert.Equal(t, []string{"First", "Second"}, titles)
+		})
+	}
+}
+
+func TestDecodeBudget(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			// Within budget.
+			feed := newTestFeed(t, format, WithMaxBytes(int64(len(data))), WithMaxTokens(1000))
+			assert.Len(t, feed.GetItems(), 3)
+
+			// Exceeding the byte budget.
+			_, err := newTestDecoder(format, strings.NewReader(data), WithMaxBytes(int64(len(data)-10)))
+			require.ErrorIs(t, err, ErrFeedTooLarge)
+			require.ErrorIs(t, err, ErrParseBytes)
+
+			for _, err := range DecodeItems(strings.NewReader(data), format, WithMaxBytes(int64(len(data)/2))) {
+				if err != nil {
+					require.ErrorIs(t, err, ErrFeedTooLarge)
+				}
+			}
+
+			if format == types.SourceTypeJSONFeed {
+				return
+			}
+			// Exceeding the token budget.
+			_, err = newTestDecoder(format, strings.NewReader(data), WithMaxTokens(10))
+			require.ErrorIs(t, err, ErrFeedTooLarge)
+
+			var streamErr error
+			for _, err := range DecodeItems(strings.NewReader(data), format, WithMaxTokens(10)) {
+				streamErr = err
+			}
+			require.ErrorIs(t, streamErr, ErrFeedTooLarge)
+		})
+	}
+}
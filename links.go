@@ -0,0 +1,228 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// Link relations commonly used in feeds, for use with Feed.GetLinksByRel. Any other relation can also be used.
+const (
+	// LinkRelAlternate is the relation of an alternate version of the feed, typically its website.
+	LinkRelAlternate = "alternate"
+	// LinkRelSelf is the relation of the feed itself.
+	LinkRelSelf = "self"
+	// LinkRelHub is the relation of a WebSub hub for the feed.
+	LinkRelHub = "hub"
+	// LinkRelNext is the relation of the next page of a paginated feed.
+	LinkRelNext = "next"
+	// LinkRelPrevious is the relation of the previous page of a paginated feed.
+	LinkRelPrevious = "previous"
+	// LinkRelFirst is the relation of the first page of a paginated feed.
+	LinkRelFirst = "first"
+	// LinkRelLast is the relation of the last page of a paginated feed.
+	LinkRelLast = "last"
+	// LinkRelPrevArchive is the relation of the previous (older) archive document of an archived feed (RFC 5005).
+	LinkRelPrevArchive = "prev-archive"
+	// LinkRelLicense is the relation of the license the content of the feed is made available under.
+	LinkRelLicense = "license"
+	// LinkRelPayment is the relation of a page where payments can be made to support the feed.
+	LinkRelPayment = "payment"
+)
+
+// ianaRelationPrefix is the prefix of the URI form of the link relations in the IANA registry, which RFC 4287 allows
+// to be used in place of their short form.
+const ianaRelationPrefix = "http://www.iana.org/assignments/relation/"
+
+// ErrLink indicates a link could not be added to a feed.
+var ErrLink = errors.New("unable to add link")
+
+// Link is a link from a Feed to a related resource.
+type Link struct {
+	// Href is the URL of the resource.
+	Href string `json:"href"`
+	// Rel is the relation of the resource to the Feed, such as "alternate", "self" or "hub".
+	Rel string `json:"rel"`
+	// Type is the media type of the resource, if known.
+	Type string `json:"type,omitempty"`
+	// Title is a human-readable description of the resource, if any.
+	Title string `json:"title,omitempty"`
+}
+
+// GetLinks returns all the links of the Feed, regardless of its format:
+//
+//   - Atom: each <link>, with a missing relation treated as "alternate".
+//   - RSS: the <link> of the channel, as "alternate", and any <atom:link>.
+//   - RDF: the <link> of the channel, as "alternate", and its rdf:about URL, as "self".
+//   - JSONFeed: the home_page_url, as "alternate", the feed_url, as "self", the next_url, as "next" and each hub, as
+//     "hub".
+func (f *Feed) GetLinks() []Link {
+	var links []Link
+	switch source := f.FeedSource.(type) {
+	case *atom.Feed:
+		for link := range slices.Values(source.Links) {
+			links = append(links, newAtomLink(link))
+		}
+	case *rss.RSS:
+		links = appendLink(links, source.Channel.Link, LinkRelAlternate, "")
+		for link := range slices.Values(source.Channel.AtomLinks) {
+			links = append(links, newAtomLink(link))
+		}
+	case *rdf.RDF:
+		links = appendLink(links, source.Channel.Link, LinkRelAlternate, "")
+		links = appendLink(links, source.Channel.About, LinkRelSelf, "")
+	case *jsonfeed.Feed:
+		links = appendLink(links, derefString(source.HomePageURL), LinkRelAlternate, "")
+		links = appendLink(links, derefString(source.FeedURL), LinkRelSelf, types.MimeTypesJSONFeed[0])
+		links = appendLink(links, derefString(source.NextURL), LinkRelNext, types.MimeTypesJSONFeed[0])
+		for hub := range slices.Values(source.Hubs) {
+			if href := strings.TrimSpace(hub.URL); href != "" {
+				links = append(links, Link{Href: href, Rel: LinkRelHub, Title: hub.Title})
+			}
+		}
+	}
+	return links
+}
+
+// GetLinksByRel returns the links of the Feed with the given relation, such as LinkRelHub for WebSub hubs or
+// LinkRelNext for pagination. Relations are compared case-insensitively, and the IANA URI form of a relation (e.g.,
+// "http://www.iana.org/assignments/relation/self") matches its short form. See GetLinks for the links of each format.
+func (f *Feed) GetLinksByRel(rel string) []Link {
+	rel = normalizeLinkRel(rel)
+	var links []Link
+	for link := range slices.Values(f.GetLinks()) {
+		if link.Rel == rel {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// GetAlternateLinks returns the links of the Feed to alternate versions of it, such as its website or the same feed in
+// another format or language.
+func (f *Feed) GetAlternateLinks() []Link {
+	return f.GetLinksByRel(LinkRelAlternate)
+}
+
+// AddLink adds a link to the given URL, with the given relation and media type (which may be empty), to the Feed,
+// regardless of its format:
+//
+//   - Atom: a <link> element.
+//   - RSS: an <atom:link> element of the channel.
+//   - RDF: the <link> of the channel for "alternate".
+//   - JSONFeed: the home_page_url for "alternate", the next_url for "next" or a hub for "hub".
+//
+// A "self" link is the source URL of the Feed and is set with SetSourceURL, for any format. Links the Feed already has
+// are not added again. Links declared by the publisher take precedence over added ones: if the Feed already has a
+// different source URL, it is kept and an ErrLink error is returned, as it is for the other links RDF and JSONFeed
+// feeds can only have one of. Use SetSourceURL to replace the source URL. An ErrLink error is also returned if the
+// format of the Feed cannot represent the relation.
+func (f *Feed) AddLink(href, rel, mediaType string) error {
+	href, rel = strings.TrimSpace(href), normalizeLinkRel(rel)
+	if href == "" {
+		return fmt.Errorf("%w: empty URL", ErrLink)
+	}
+	existing := f.GetLinksByRel(rel)
+	if slices.ContainsFunc(existing, func(link Link) bool { return link.Href == href }) {
+		return nil
+	}
+	if rel == LinkRelSelf {
+		if sourceURL := f.GetSourceURL(); sourceURL != "" {
+			return fmt.Errorf("%w: feed already has source URL %q", ErrLink, sourceURL)
+		}
+		f.SetSourceURL(href)
+		return nil
+	}
+
+	switch source := f.FeedSource.(type) {
+	case *atom.Feed:
+		source.Links = append(source.Links, newLink(href, rel, mediaType))
+		return nil
+	case *rss.RSS:
+		source.Channel.AtomLinks = append(source.Channel.AtomLinks, newLink(href, rel, mediaType))
+		return nil
+	case *rdf.RDF:
+		if rel == LinkRelAlternate && len(existing) == 0 {
+			source.Channel.Link = href
+			return nil
+		}
+	case *jsonfeed.Feed:
+		switch {
+		case rel == LinkRelAlternate && len(existing) == 0:
+			source.HomePageURL = &href
+			return nil
+		case rel == LinkRelNext && len(existing) == 0:
+			source.NextURL = &href
+			return nil
+		case rel == LinkRelHub:
+			source.Hubs = append(source.Hubs, jsonfeed.Hub{Title: webSubHubTitle, URL: href})
+			return nil
+		}
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("%w: %s feeds can only have one %q link", ErrLink, f.SourceType, rel)
+	}
+	return fmt.Errorf("%w: %s feeds cannot have %q links", ErrLink, f.SourceType, rel)
+}
+
+// newLink creates an Atom link (which is also the type of an RSS <atom:link>) with the given values.
+func newLink(href, rel, mediaType string) atom.Link {
+	link := atom.Link{Href: href, Rel: atom.LinkRel(rel)}
+	if mediaType != "" {
+		link.Type = &mediaType
+	}
+	return link
+}
+
+// newAtomLink creates a Link from the given Atom link.
+func newAtomLink(link atom.Link) Link {
+	return Link{
+		Href:  strings.TrimSpace(link.Href),
+		Rel:   normalizeLinkRel(string(link.Rel)),
+		Type:  derefString(link.Type),
+		Title: derefString(link.Title),
+	}
+}
+
+// appendLink appends a Link with the given values to links, if href is not empty.
+func appendLink(links []Link, href, rel, mediaType string) []Link {
+	if href = strings.TrimSpace(href); href == "" {
+		return links
+	}
+	return append(links, Link{Href: href, Rel: rel, Type: mediaType})
+}
+
+// normalizeLinkRel returns the normalized form of the given link relation, for comparison. An empty relation is
+// treated as "alternate", as in Atom.
+func normalizeLinkRel(rel string) string {
+	rel = strings.TrimSpace(rel)
+	if rel == "" {
+		return LinkRelAlternate
+	}
+	if after, found := strings.CutPrefix(rel, ianaRelationPrefix); found {
+		rel = after
+	}
+	if strings.Contains(rel, ":") {
+		// Extension relations are URIs, which are compared as is.
+		return rel
+	}
+	return strings.ToLower(rel)
+}
+
+// derefString returns the value of the given string pointer, or an empty string if it is nil.
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
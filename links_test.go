@@ -0,0 +1,153 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedGetLinksByRel(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		rel  string
+		want []Link
+	}{
+		{
+			name: "atom hub",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title>` +
+				`<link href="https://example.com/"/><link rel="hub" href="https://hub.example.com/"/>` +
+				`<link rel="HUB" href="https://hub2.example.com/"/></feed>`,
+			rel: LinkRelHub,
+			want: []Link{
+				{Href: "https://hub.example.com/", Rel: LinkRelHub},
+				{Href: "https://hub2.example.com/", Rel: LinkRelHub},
+			},
+		},
+		{
+			name: "atom alternate without rel",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title>` +
+				`<link href="https://example.com/" type="text/html"/>` +
+				`<link rel="http://www.iana.org/assignments/relation/license" href="https://example.com/license"/></feed>`,
+			rel:  LinkRelAlternate,
+			want: []Link{{Href: "https://example.com/", Rel: LinkRelAlternate, Type: "text/html"}},
+		},
+		{
+			name: "atom iana license",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title>` +
+				`<link rel="http://www.iana.org/assignments/relation/license" href="https://example.com/license"/></feed>`,
+			rel:  LinkRelLicense,
+			want: []Link{{Href: "https://example.com/license", Rel: LinkRelLicense}},
+		},
+		{
+			name: "rss atom:link",
+			data: `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Example</title>` +
+				`<link>https://example.com/</link><atom:link rel="next" href="https://example.com/feed?page=2"/>` +
+				`</channel></rss>`,
+			rel:  LinkRelNext,
+			want: []Link{{Href: "https://example.com/feed?page=2", Rel: LinkRelNext}},
+		},
+		{
+			name: "rss alternate",
+			data: `<rss version="2.0"><channel><title>Example</title><link>https://example.com/</link></channel></rss>`,
+			rel:  LinkRelAlternate,
+			want: []Link{{Href: "https://example.com/", Rel: LinkRelAlternate}},
+		},
+		{
+			name: "jsonfeed hubs",
+			data: `{"version":"https://jsonfeed.org/version/1.1","title":"Example",` +
+				`"hubs":[{"type":"WebSub","url":"https://hub.example.com/"}],"items":[]}`,
+			rel:  LinkRelHub,
+			want: []Link{{Href: "https://hub.example.com/", Rel: LinkRelHub}},
+		},
+		{
+			name: "none",
+			data: `<rss version="2.0"><channel><title>Example</title><link>https://example.com/</link></channel></rss>`,
+			rel:  LinkRelPayment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, feed.GetLinksByRel(tt.rel))
+		})
+	}
+}
+
+func TestFeedAddLink(t *testing.T) {
+	const (
+		atomData = `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title></feed>`
+		rssData  = `<rss version="2.0"><channel><title>Example</title><link>https://example.com/</link></channel></rss>`
+		rdfData  = `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"` +
+			` xmlns="http://purl.org/rss/1.0/"><channel rdf:about="https://example.com/feed.rdf">` +
+			`<title>Example</title><link>https://example.com/</link></channel></rdf:RDF>`
+		jsonData = `{"version":"https://jsonfeed.org/version/1.1","title":"Example","items":[]}`
+	)
+	tests := []struct {
+		name     string
+		data     string
+		href     string
+		rel      string
+		wantErr  bool
+		wantSelf string
+		want     []Link
+	}{
+		{
+			name: "atom hub", data: atomData, href: "https://hub.example.com/", rel: "HUB",
+			want: []Link{{Href: "https://hub.example.com/", Rel: LinkRelHub}},
+		},
+		{
+			name: "atom self", data: atomData, href: "https://example.com/feed", rel: LinkRelSelf,
+			wantSelf: "https://example.com/feed",
+			want:     []Link{{Href: "https://example.com/feed", Rel: LinkRelSelf, Type: "application/atom+xml"}},
+		},
+		{
+			name: "rss next", data: rssData, href: "https://example.com/feed?page=2", rel: LinkRelNext,
+			want: []Link{{Href: "https://example.com/feed?page=2", Rel: LinkRelNext}},
+		},
+		{
+			name: "rss existing alternate", data: rssData, href: "https://example.com/", rel: LinkRelAlternate,
+			want: []Link{{Href: "https://example.com/", Rel: LinkRelAlternate}},
+		},
+		{
+			name: "rdf declared self", data: rdfData, href: "https://mirror.example.com/feed.rdf", rel: LinkRelSelf,
+			wantErr: true, wantSelf: "https://example.com/feed.rdf",
+			want: []Link{{Href: "https://example.com/feed.rdf", Rel: LinkRelSelf}},
+		},
+		{
+			name: "rdf hub", data: rdfData, href: "https://hub.example.com/", rel: LinkRelHub, wantErr: true,
+			wantSelf: "https://example.com/feed.rdf",
+		},
+		{
+			name: "jsonfeed next", data: jsonData, href: "https://example.com/feed.json?page=2", rel: LinkRelNext,
+			want: []Link{
+				{Href: "https://example.com/feed.json?page=2", Rel: LinkRelNext, Type: "application/feed+json"},
+			},
+		},
+		{
+			name: "jsonfeed license", data: jsonData, href: "https://example.com/license", rel: LinkRelLicense,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			err = feed.AddLink(tt.href, tt.rel, "")
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrLink)
+			} else {
+				require.NoError(t, err)
+				// Adding the same link again does nothing.
+				require.NoError(t, feed.AddLink(tt.href, tt.rel, ""))
+			}
+			assert.Equal(t, tt.want, feed.GetLinksByRel(tt.rel))
+			assert.Equal(t, tt.wantSelf, feed.GetSourceURL())
+		})
+	}
+}
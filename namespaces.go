@@ -0,0 +1,40 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/extensions"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// Namespaces returns the prefixed XML namespaces declared on the root element of the Feed, in the order they were
+// declared. This can be used to detect which extensions a feed uses (e.g., itunes, media or dc) and enable features
+// conditionally; see also HasNamespace. JSONFeed feeds have no namespaces, so nil is returned for them.
+func (f *Feed) Namespaces() []extensions.Namespace {
+	switch source := f.FeedSource.(type) {
+	case *rss.RSS:
+		return slices.Clone(source.Namespaces)
+	case *atom.Feed:
+		return slices.Clone(source.Namespaces)
+	case *rdf.RDF:
+		return slices.Clone(source.Namespaces)
+	default:
+		return nil
+	}
+}
+
+// HasNamespace reports whether the Feed declares the XML namespace with the given URI, regardless of the prefix it
+// is declared with. Trailing slashes are ignored when comparing URIs, as feeds are inconsistent in including them. The
+// URIs of common namespaces can be found in extensions.WellKnownNamespaces.
+func (f *Feed) HasNamespace(uri string) bool {
+	uri = strings.TrimSuffix(uri, "/")
+	return slices.ContainsFunc(f.Namespaces(), func(namespace extensions.Namespace) bool {
+		return strings.TrimSuffix(namespace.URI, "/") == uri
+	})
+}
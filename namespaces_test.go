@@ -0,0 +1,52 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/extensions"
+)
+
+func TestFeedNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		want       []extensions.Namespace
+		wantItunes bool
+	}{
+		{
+			name: "rss",
+			data: `<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" ` +
+				`xmlns:dc="http://purl.org/dc/elements/1.1/"><channel><title>Example</title></channel></rss>`,
+			want: []extensions.Namespace{
+				{Prefix: "itunes", URI: extensions.WellKnownNamespaces["itunes"]},
+				{Prefix: "dc", URI: extensions.WellKnownNamespaces["dc"]},
+			},
+			wantItunes: true,
+		},
+		{
+			name: "atom",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss">` +
+				`<title>Example</title></feed>`,
+			want: []extensions.Namespace{{Prefix: "media", URI: "http://search.yahoo.com/mrss"}},
+		},
+		{
+			name: "jsonfeed",
+			data: `{"version":"https://jsonfeed.org/version/1.1","title":"Example","items":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, feed.Namespaces())
+			assert.Equal(t, tt.wantItunes, feed.HasNamespace(extensions.WellKnownNamespaces["itunes"]))
+			assert.Equal(t, tt.name == "atom", feed.HasNamespace(extensions.WellKnownNamespaces["media"]))
+		})
+	}
+}
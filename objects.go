@@ -5,18 +5,29 @@ package feeds
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"iter"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/immanent-tech/go-syndication/atom"
 	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
 	"github.com/immanent-tech/go-syndication/rss"
 	"github.com/immanent-tech/go-syndication/types"
+	"github.com/immanent-tech/go-syndication/validation"
 )
 
-// ErrUnmarshal indicates an error occurred trying to unmarshal data into a given feed object.
-var ErrUnmarshal = errors.New("unmarshaling object failed")
+var (
+	// ErrUnmarshal indicates an error occurred trying to unmarshal data into a given feed object.
+	ErrUnmarshal = errors.New("unmarshaling object failed")
+	// ErrMarshal indicates an error occurred trying to marshal a feed object.
+	ErrMarshal = errors.New("marshaling object failed")
+)
 
 // Item represents a single item or entry (or article) in a feed.
 type Item struct {
@@ -24,39 +35,128 @@ type Item struct {
 
 	SourceType types.SourceType `json:"type"`
 	FeedTitle  string           `json:"feed_title"`
+
+	feedLanguage *string
+	feedAuthors  []string
+	feedRights   *string
+	config       *config
+	raw          []byte
+}
+
+// GetLanguage retrieves the language of the Item. If the item does not declare a language, the language of the feed it
+// belongs to is used. If neither declares a language and the feed was created with the WithLanguageDetection option,
+// the language will be detected from the title, description and content of the item.
+func (i *Item) GetLanguage() *string {
+	if lang := i.ItemSource.GetLanguage(); lang != nil && *lang != "" {
+		return lang
+	}
+	if i.feedLanguage != nil && *i.feedLanguage != "" {
+		return i.feedLanguage
+	}
+	if i.config != nil && i.config.detectLanguage {
+		if lang := detectItemLanguage(i.GetTitle(), i.ItemSource.GetDescription(), i.ItemSource.GetContent()); lang != "" {
+			return &lang
+		}
+	}
+	return nil
+}
+
+// GetAuthors retrieves the authors of the Item. If the item has no authors and the Feed was created with the
+// WithFeedInheritance option, the authors of the feed are returned.
+func (i *Item) GetAuthors() []string {
+	if authors := i.ItemSource.GetAuthors(); len(authors) > 0 {
+		return authors
+	}
+	if i.config != nil && i.config.inheritFeed {
+		return i.feedAuthors
+	}
+	return nil
+}
+
+// GetRights retrieves the rights (i.e., copyright) of the Item. If the item does not declare any rights and the Feed
+// was created with the WithFeedInheritance option, the rights of the feed are returned.
+func (i *Item) GetRights() *string {
+	if rights := i.ItemSource.GetRights(); rights != nil && *rights != "" {
+		return rights
+	}
+	if i.config != nil && i.config.inheritFeed {
+		return i.feedRights
+	}
+	return nil
+}
+
+// GetImage retrieves the image (if any) for the Item. If the Feed was created with the WithImageURLRewriter option, the
+// image URL will be rewritten.
+func (i *Item) GetImage() *types.ImageInfo {
+	return i.config.rewriteImage(i.ItemSource.GetImage())
+}
+
+// GetCommentsURL retrieves the URL of the comments (if any) on the Item. This is the <comments> or <wfw:commentRss> of
+// an RSS item, or the rel="replies" link of an Atom entry. For other formats, an empty string is returned.
+func (i *Item) GetCommentsURL() string {
+	if source, ok := i.ItemSource.(types.HasComments); ok {
+		return source.GetCommentsURL()
+	}
+	return ""
 }
 
-// UnmarshalJSON handles unmarshaling of an Item from JSON.
+// GetDescription retrieves the description (if any) of the Item. If the Feed was created with the WithSanitizationPolicy
+// option, the description is sanitized with the policy. If the Feed was created with the WithImageURLRewriter option,
+// the URL of any images in the description will be rewritten. If the Feed was created with the
+// WithDescriptionDeduplication option and the description duplicates the content, an empty string is returned.
+func (i *Item) GetDescription() string {
+	defer i.config.recordSanitization(time.Now())
+	description := i.ItemSource.GetDescription()
+	if i.config != nil && i.config.dedupeDescription {
+		if content := i.ItemSource.GetContent(); content != nil && IsDuplicateContent(description, *content) {
+			return ""
+		}
+	}
+	return i.config.rewriteContentImages(i.config.sanitize(description))
+}
+
+// GetContent retrieves the content (if any) of the Item. If the Feed was created with the WithSanitizationPolicy option,
+// the content is sanitized with the policy. If the Feed was created with the WithImageURLRewriter option, the URL of any
+// images in the content will be rewritten.
+func (i *Item) GetContent() *string {
+	defer i.config.recordSanitization(time.Now())
+	content := i.ItemSource.GetContent()
+	if content == nil {
+		return nil
+	}
+	return new(i.config.rewriteContentImages(i.config.sanitize(*content)))
+}
+
+// UnmarshalJSON handles unmarshaling of an Item from JSON. Data from any earlier version of the JSON schema is
+// migrated to the current version.
 func (i *Item) UnmarshalJSON(v []byte) error {
-	// Unmarshal the FeedSource based on the type field value.
-	sourceType, source, err := sourceFromBytes(v)
+	// Unmarshal the ItemSource based on the type field value.
+	envelope, err := decodeEnvelope(v)
 	if err != nil {
 		return err
 	}
-	switch sourceType {
+	switch envelope.Type {
 	case types.SourceTypeAtom:
-		i.SourceType = sourceType
-		i.ItemSource, err = unmarshalSource[*atom.Entry](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into Atom: %w", ErrUnmarshal, err)
-		}
-		return nil
+		i.ItemSource, err = unmarshalSource[*atom.Entry](envelope.Source)
 	case types.SourceTypeRSS:
-		i.SourceType = sourceType
-		i.ItemSource, err = unmarshalSource[*rss.Item](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into RSS: %w", ErrUnmarshal, err)
-		}
-		return nil
+		i.ItemSource, err = unmarshalSource[*rss.Item](envelope.Source)
+	case types.SourceTypeRDF:
+		i.ItemSource, err = unmarshalSource[*rdf.Item](envelope.Source)
 	case types.SourceTypeJSONFeed:
-		i.SourceType = sourceType
-		i.ItemSource, err = unmarshalSource[*jsonfeed.Item](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into JSONFeed: %w", ErrUnmarshal, err)
+		i.ItemSource, err = unmarshalSource[*jsonfeed.Item](envelope.Source)
+	default:
+		registered, found := lookupFormat(envelope.Type)
+		if !found {
+			return fmt.Errorf("%w: unknown data type", ErrUnmarshal)
 		}
-		return nil
+		i.ItemSource, err = registered.unmarshalItem(envelope.Source)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: unable to unmarshal into %s: %w", ErrUnmarshal, envelope.Type, err)
 	}
-	return fmt.Errorf("%w: unknown data type", ErrUnmarshal)
+	i.SourceType = envelope.Type
+	i.FeedTitle = envelope.FeedTitle
+	return nil
 }
 
 // Feed represents any feed type containing a number of items.
@@ -64,72 +164,183 @@ type Feed struct {
 	types.FeedSource `json:"source"`
 
 	SourceType types.SourceType `json:"type"`
+
+	config      *config
+	validation  *validationResult
+	raw         *rawSource
+	contentType string
+	fetchURLs   []string
+	repairs     []Repair
+	stats       *ParseStats
+}
+
+// validationResult holds the cached result of validating a Feed.
+type validationResult struct {
+	err  error
+	once sync.Once
+}
+
+// Validate validates the Feed source. If the Feed was created with the WithURLSchemes option, the links of the Feed and
+// its items must also have one of the accepted schemes. Validation is relatively expensive, so the result is cached and
+// subsequent calls return the same result. If the source is modified after validation, call FeedSource.Validate
+// directly to validate it again.
+func (f *Feed) Validate() error {
+	if f.validation == nil {
+		return f.validate()
+	}
+	f.validation.once.Do(func() {
+		f.validation.err = f.validate()
+	})
+	return f.validation.err
+}
+
+// validate validates the Feed source and the schemes of the links of the Feed and its items.
+func (f *Feed) validate() error {
+	if err := f.FeedSource.Validate(); err != nil {
+		return err
+	}
+	if f.config == nil || len(f.config.urlSchemes) == 0 {
+		return nil
+	}
+	errs := &validation.StructError{}
+	check := func(namespace, rawURL string) {
+		if rawURL == "" || f.config.allowedURL(rawURL) {
+			return
+		}
+		errs.Fields = append(errs.Fields, validation.FieldError{
+			Namespace: namespace,
+			Field:     namespace[strings.LastIndex(namespace, ".")+1:],
+			Tag:       "urlscheme",
+			Value:     rawURL,
+			Param:     strings.Join(f.config.urlSchemes, " "),
+			Message:   "URL scheme is not accepted",
+		})
+	}
+	for idx, link := range f.GetLinks() {
+		check(fmt.Sprintf("Feed.Links[%d]", idx), link.Href)
+	}
+	if img := f.FeedSource.GetImage(); img != nil {
+		check("Feed.Image", img.URL)
+	}
+	for idx, item := range f.GetItems() {
+		check(fmt.Sprintf("Feed.Items[%d].Link", idx), item.GetLink())
+		if img := item.ItemSource.GetImage(); img != nil {
+			check(fmt.Sprintf("Feed.Items[%d].Image", idx), img.URL)
+		}
+		for encIdx, enclosure := range item.GetEnclosures() {
+			check(fmt.Sprintf("Feed.Items[%d].Enclosures[%d]", idx, encIdx), enclosure.URL)
+		}
+	}
+	if len(errs.Fields) > 0 {
+		return fmt.Errorf("url scheme validation failed: %w", errs)
+	}
+	return nil
+}
+
+// GetImage retrieves the image (if any) for the Feed. If the Feed was created with the WithImageURLRewriter option, the
+// image URL will be rewritten.
+func (f *Feed) GetImage() *types.ImageInfo {
+	return f.config.rewriteImage(f.FeedSource.GetImage())
+}
+
+// GetIcon retrieves the icon (if any) for the Feed. The icon is a small image suitable for lists of feeds, whereas the
+// image returned by GetImage may be a larger banner or logo. Only Atom (<icon>) and JSON Feed (favicon or icon) feeds
+// define an icon; for other formats, nil is returned. If the Feed was created with the WithImageURLRewriter option,
+// the icon URL will be rewritten.
+func (f *Feed) GetIcon() *types.ImageInfo {
+	source, ok := f.FeedSource.(types.HasIcon)
+	if !ok {
+		return nil
+	}
+	return f.config.rewriteImage(source.GetIcon())
 }
 
 // GetItems retrieves a slice of Item for the Feed.
 func (f *Feed) GetItems() []Item {
-	items := make([]Item, 0, len(f.FeedSource.GetItems()))
-	for item := range slices.Values(f.FeedSource.GetItems()) {
-		items = append(items,
-			Item{
+	return slices.Collect(f.ItemsSeq())
+}
+
+// ItemsSeq returns an iterator over the Item values of the Feed. Unlike GetItems, it does not allocate a slice.
+func (f *Feed) ItemsSeq() iter.Seq[Item] {
+	return func(yield func(Item) bool) {
+		feedTitle, feedLanguage := f.GetTitle(), f.GetLanguage()
+		var (
+			feedAuthors []string
+			feedRights  *string
+		)
+		if f.config != nil && f.config.inheritFeed {
+			feedAuthors, feedRights = f.GetAuthors(), f.GetRights()
+		}
+		var idx int
+		for item := range f.FeedSource.ItemsSeq() {
+			if !yield(Item{
 				ItemSource: item,
 				SourceType: f.SourceType,
-				FeedTitle:  f.GetTitle(),
-			})
+				FeedTitle:  feedTitle,
+
+				feedLanguage: feedLanguage,
+				feedAuthors:  feedAuthors,
+				feedRights:   feedRights,
+				config:       f.config,
+				raw:          f.rawItem(idx),
+			}) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// MarshalXML handles marshaling of a Feed to XML. The Feed is marshaled as the native document of its source (e.g., an
+// <rss> or <feed> element), with any namespaces used by the source declared, rather than as a wrapper element. The
+// given start element is ignored. JSONFeed sources cannot be marshaled to XML.
+func (f *Feed) MarshalXML(enc *xml.Encoder, _ xml.StartElement) error {
+	switch source := f.FeedSource.(type) {
+	case *rss.RSS:
+		source.AutoDeclareNamespaces()
+	case *rdf.RDF:
+		source.Link()
+		source.AutoDeclareNamespaces()
+	case *atom.Feed:
+		source.AutoDeclareNamespaces()
+	default:
+		return fmt.Errorf("%w: cannot marshal %s feed as XML", ErrMarshal, f.SourceType)
+	}
+	if err := enc.Encode(f.FeedSource); err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshal, err)
 	}
-	return items
+	return nil
 }
 
-// UnmarshalJSON handles unmarshaling of a Feed from JSON.
+// UnmarshalJSON handles unmarshaling of a Feed from JSON. Data from any earlier version of the JSON schema is
+// migrated to the current version.
 func (f *Feed) UnmarshalJSON(v []byte) error {
 	// Unmarshal the FeedSource based on the type field value.
-	sourceType, source, err := sourceFromBytes(v)
+	envelope, err := decodeEnvelope(v)
 	if err != nil {
 		return err
 	}
-	switch sourceType {
+	switch envelope.Type {
 	case types.SourceTypeAtom:
-		f.SourceType = sourceType
-		f.FeedSource, err = unmarshalSource[*atom.Feed](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into Atom: %w", ErrUnmarshal, err)
-		}
-		return nil
+		f.FeedSource, err = unmarshalSource[*atom.Feed](envelope.Source)
 	case types.SourceTypeRSS:
-		f.SourceType = sourceType
-		f.FeedSource, err = unmarshalSource[*rss.RSS](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into RSS: %w", ErrUnmarshal, err)
-		}
-		return nil
+		f.FeedSource, err = unmarshalSource[*rss.RSS](envelope.Source)
+	case types.SourceTypeRDF:
+		f.FeedSource, err = unmarshalSource[*rdf.RDF](envelope.Source)
 	case types.SourceTypeJSONFeed:
-		f.SourceType = sourceType
-		f.FeedSource, err = unmarshalSource[*jsonfeed.Feed](source)
-		if err != nil {
-			return fmt.Errorf("%w: unable to unmarshal into JSONFeed: %w", ErrUnmarshal, err)
+		f.FeedSource, err = unmarshalSource[*jsonfeed.Feed](envelope.Source)
+	default:
+		registered, found := lookupFormat(envelope.Type)
+		if !found {
+			return fmt.Errorf("%w: unknown data type", ErrUnmarshal)
 		}
-		return nil
-	}
-	return fmt.Errorf("%w: unknown data type", ErrUnmarshal)
-}
-
-func sourceFromBytes(v []byte) (types.SourceType, json.RawMessage, error) {
-	topLevel := make(map[string]json.RawMessage)
-	err := json.Unmarshal(v, &topLevel)
-	if err != nil {
-		return "", nil, fmt.Errorf("%w: %w", ErrUnmarshal, err)
-	}
-	// Check for a type field and unmarshal its value if found.
-	rawType, found := topLevel["type"]
-	if !found {
-		return "", nil, fmt.Errorf("%w: unknown data type", ErrUnmarshal)
+		f.FeedSource, err = registered.unmarshalFeed(envelope.Source)
 	}
-	var sourceType types.SourceType
-	err = json.Unmarshal(rawType, &sourceType)
 	if err != nil {
-		return "", nil, fmt.Errorf("%w: %w", ErrUnmarshal, err)
+		return fmt.Errorf("%w: unable to unmarshal into %s: %w", ErrUnmarshal, envelope.Type, err)
 	}
-	return sourceType, topLevel["source"], nil
+	f.SourceType = envelope.Type
+	return nil
 }
 
 func unmarshalSource[T any](v json.RawMessage) (T, error) {
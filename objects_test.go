@@ -0,0 +1,320 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func newTestDecoder(format types.SourceType, data io.Reader, options ...Option) (*Feed, error) {
+	switch format {
+	case types.SourceTypeRSS:
+		return NewDecoder[*rss.RSS](data, options...)
+	case types.SourceTypeAtom:
+		return NewDecoder[*atom.Feed](data, options...)
+	case types.SourceTypeRDF:
+		return NewDecoder[*rdf.RDF](data, options...)
+	case types.SourceTypeJSONFeed:
+		return NewDecoder[*jsonfeed.Feed](data, options...)
+	default:
+		return nil, ErrParseBytes
+	}
+}
+
+func newTestFeed(t *testing.T, format types.SourceType, options ...Option) *Feed {
+	t.Helper()
+	feed, err := newTestDecoder(format, strings.NewReader(streamTests[format]), options...)
+	require.NoError(t, err)
+	return feed
+}
+
+func TestFeedItemsSeq(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+
+			var titles []string
+			for item := range feed.ItemsSeq() {
+				assert.Equal(t, "Example Feed", item.FeedTitle)
+				titles = append(titles, item.GetTitle())
+			}
+			assert.Equal(t, []string{"First", "Second", "Third"}, titles)
+
+			var sourceTitles []string
+			for item := range feed.FeedSource.ItemsSeq() {
+				sourceTitles = append(sourceTitles, item.GetTitle())
+				break
+			}
+			assert.Equal(t, []string{"First"}, sourceTitles)
+		})
+	}
+}
+
+func TestFeedInheritance(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title>` +
+		`<author><name>Feed Author</name></author><rights>CC BY 4.0</rights>` +
+		`<entry><id>a</id><title>A</title></entry>` +
+		`<entry><id>b</id><title>B</title><author><name>Item Author</name></author><rights>All rights reserved</rights>` +
+		`</entry></feed>`)
+	tests := []struct {
+		name        string
+		options     []Option
+		wantAuthors [][]string
+		wantRights  []*string
+	}{
+		{
+			name:        "inherit",
+			options:     []Option{WithFeedInheritance()},
+			wantAuthors: [][]string{{"Feed Author"}, {"Item Author"}},
+			wantRights:  []*string{new("CC BY 4.0"), new("All rights reserved")},
+		},
+		{
+			name:        "default",
+			wantAuthors: [][]string{nil, {"Item Author"}},
+			wantRights:  []*string{nil, new("All rights reserved")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes(data, tt.options...)
+			require.NoError(t, err)
+			var (
+				authors [][]string
+				rights  []*string
+			)
+			for item := range feed.ItemsSeq() {
+				authors = append(authors, item.GetAuthors())
+				rights = append(rights, item.GetRights())
+			}
+			assert.Equal(t, tt.wantAuthors, authors)
+			assert.Equal(t, tt.wantRights, rights)
+		})
+	}
+}
+
+func TestFeedMutators(t *testing.T) {
+	published := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+			feed.SetTitle("New Title")
+			feed.SetDescription("New description")
+			feed.SetLink("https://example.org/")
+			feed.AddCategory("news")
+
+			item := feed.FeedSource.GetItems()[0]
+			item.SetTitle("New Item")
+			item.SetDescription("New item description")
+			item.SetLink("https://example.org/new")
+			item.SetPublishedDate(published)
+			item.AddCategory("tech")
+			require.NoError(t, feed.AddItem(item))
+			var foreign types.ItemSource = &rss.Item{}
+			if format == types.SourceTypeRSS {
+				foreign = &atom.Entry{}
+			}
+			require.ErrorIs(t, feed.AddItem(foreign), types.ErrIncompatibleItem)
+
+			// The changes survive re-encoding the feed.
+			var (
+				data []byte
+				err  error
+			)
+			if format == types.SourceTypeJSONFeed {
+				data, err = json.Marshal(feed.FeedSource)
+			} else {
+				data, err = Encode(feed.FeedSource)
+			}
+			require.NoError(t, err)
+			decoded, err := NewFeedFromBytes(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, "New Title", decoded.GetTitle())
+			assert.Equal(t, "New description", decoded.GetDescription())
+			assert.Equal(t, "https://example.org/", decoded.GetLink())
+			if format != types.SourceTypeJSONFeed {
+				assert.Contains(t, decoded.GetCategories(), "news")
+			}
+			items := decoded.GetItems()
+			require.Len(t, items, 4)
+			for _, item := range []Item{items[0], items[3]} {
+				assert.Equal(t, "New Item", item.GetTitle())
+				assert.Equal(t, "New item description", item.GetDescription())
+				assert.Equal(t, "https://example.org/new", item.GetLink())
+				assert.Equal(t, published, item.GetPublishedDate().UTC())
+				assert.Contains(t, item.GetCategories(), "tech")
+			}
+		})
+	}
+}
+
+func TestFeedGetItemsMutation(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+
+			items := feed.FeedSource.GetItems()
+			require.Len(t, items, 3)
+			switch item := items[1].(type) {
+			case *rss.Item:
+				item.Title = "Edited"
+			case *atom.Entry:
+				item.Title.Value = "Edited"
+			case *rdf.Item:
+				item.Title = "Edited"
+			case *jsonfeed.Item:
+				item.Title = new("Edited")
+			}
+
+			// Each call should return the same underlying items, with the edit visible.
+			again := feed.FeedSource.GetItems()
+			for idx := range items {
+				assert.Same(t, items[idx], again[idx])
+			}
+			assert.NotSame(t, again[0], again[1])
+			assert.Equal(t, "First", again[0].GetTitle())
+			assert.Equal(t, "Edited", again[1].GetTitle())
+			var titles []string
+			for item := range feed.ItemsSeq() {
+				titles = append(titles, item.GetTitle())
+			}
+			assert.Equal(t, []string{"First", "Edited", "Third"}, titles)
+		})
+	}
+}
+
+func TestFeedMarshalXML(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed := newTestFeed(t, format)
+
+			data, err := xml.Marshal(feed)
+			if format == types.SourceTypeJSONFeed {
+				require.ErrorIs(t, err, ErrMarshal)
+				return
+			}
+			require.NoError(t, err)
+
+			// The output should be the native document of the source, which decodes back to the same feed.
+			got, err := NewFeedFromBytes(data)
+			require.NoError(t, err)
+			assert.Equal(t, format, got.SourceType)
+			assert.Equal(t, feed.GetTitle(), got.GetTitle())
+			require.Len(t, got.GetItems(), len(feed.GetItems()))
+			for idx, item := range got.GetItems() {
+				assert.Equal(t, feed.GetItems()[idx].GetTitle(), item.GetTitle())
+				assert.Equal(t, feed.GetItems()[idx].GetLink(), item.GetLink())
+			}
+		})
+	}
+}
+
+func TestFeedGetIcon(t *testing.T) {
+	tests := []struct {
+		source types.FeedSource
+		want   *types.ImageInfo
+		image  string
+		name   string
+	}{
+		{
+			name: "atom",
+			source: &atom.Feed{
+				Title: atom.Title{Value: "Example"},
+				Logo:  &atom.Logo{Value: "https://example.com/logo.png"},
+				Icon:  &atom.Icon{Value: "https://example.com/icon.png"},
+			},
+			want:  &types.ImageInfo{URL: "https://example.com/icon.png", Title: "Example"},
+			image: "https://example.com/logo.png",
+		},
+		{
+			name: "jsonfeed favicon",
+			source: &jsonfeed.Feed{
+				Icon:    new("https://example.com/icon.png"),
+				Favicon: new("https://example.com/favicon.ico"),
+			},
+			want:  &types.ImageInfo{URL: "https://example.com/favicon.ico"},
+			image: "https://example.com/icon.png",
+		},
+		{
+			name:   "jsonfeed icon",
+			source: &jsonfeed.Feed{Icon: new("https://example.com/icon.png")},
+			want:   &types.ImageInfo{URL: "https://example.com/icon.png"},
+			image:  "https://example.com/icon.png",
+		},
+		{
+			name: "rss",
+			source: &rss.RSS{Channel: rss.Channel{
+				Title: "Example",
+				Image: &rss.Image{URL: "https://example.com/logo.png", Title: "Logo"},
+			}},
+			image: "https://example.com/logo.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := NewFeedFromSource(tt.source)
+			assert.Equal(t, tt.want, feed.GetIcon())
+			require.NotNil(t, feed.GetImage())
+			assert.Equal(t, tt.image, feed.GetImage().URL)
+		})
+	}
+}
+
+func TestItemGetCommentsURL(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "rss comments",
+			data: `<rss version="2.0" xmlns:wfw="http://wellformedweb.org/CommentAPI/"><channel><title>Example</title>` +
+				`<item><title>Item</title><comments>https://example.com/1#comments</comments>` +
+				`<wfw:commentRss>https://example.com/1/comments/feed</wfw:commentRss></item></channel></rss>`,
+			want: "https://example.com/1#comments",
+		},
+		{
+			name: "rss wfw commentRss",
+			data: `<rss version="2.0" xmlns:wfw="http://wellformedweb.org/CommentAPI/"><channel><title>Example</title>` +
+				`<item><title>Item</title><wfw:commentRss>https://example.com/1/comments/feed</wfw:commentRss>` +
+				`</item></channel></rss>`,
+			want: "https://example.com/1/comments/feed",
+		},
+		{
+			name: "atom replies",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title><entry><title>Entry</title>` +
+				`<link href="https://example.com/1"/>` +
+				`<link rel="replies" type="application/atom+xml" href="https://example.com/1/comments.xml"/>` +
+				`<link rel="replies" type="text/html" href="https://example.com/1#comments"/></entry></feed>`,
+			want: "https://example.com/1#comments",
+		},
+		{
+			name: "rss no comments",
+			data: `<rss version="2.0"><channel><title>Example</title><item><title>Item</title></item></channel></rss>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			items := feed.GetItems()
+			require.Len(t, items, 1)
+			assert.Equal(t, tt.want, items[0].GetCommentsURL())
+		})
+	}
+}
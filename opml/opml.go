@@ -58,6 +58,20 @@ func WithTitle(title string) Option {
 	}
 }
 
+// WithOwnerName option sets the name of the owner of the OPML object.
+func WithOwnerName(name string) Option {
+	return func(o *OPML) {
+		o.Head.OwnerName = name
+	}
+}
+
+// WithOwnerEmail option sets the email address of the owner of the OPML object.
+func WithOwnerEmail(email string) Option {
+	return func(o *OPML) {
+		o.Head.OwnerEmail = email
+	}
+}
+
 // WithOutlines option appends the given outlines to the OPML object.
 func WithOutlines(outlines ...Outline) Option {
 	return func(o *OPML) {
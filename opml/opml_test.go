@@ -4,11 +4,20 @@
 package opml
 
 import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/immanent-tech/go-syndication/conformance"
+	"github.com/immanent-tech/go-syndication/rss"
 	"github.com/immanent-tech/go-syndication/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -126,3 +135,182 @@ func TestNewOPML(t *testing.T) {
 		})
 	}
 }
+
+func TestOPMLSubscriptions(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?><opml version="2.0"><head><title>Subscriptions</title></head><body>` +
+		`<outline text="Top" title="Top Feed" type="rss" xmlUrl="https://example.com/top.xml"/>` +
+		`<outline text="Tech">` +
+		`<outline text="Go" type="rss" xmlUrl="https://example.com/go.xml" htmlUrl="https://example.com/go"/>` +
+		`<outline text="Web"><outline text="CSS" type="rss" xmlUrl="https://example.com/css.xml"/></outline>` +
+		`</outline>` +
+		`<outline text="Old" isComment="true"><outline text="Gone" type="rss" xmlUrl="https://example.com/gone.xml"/>` +
+		`</outline>` +
+		`<outline text="Duplicate" type="rss" xmlUrl="https://example.com/go.xml"/>` +
+		`</body></opml>`)
+	opml, err := NewOPMLFromBytes(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Subscription{
+		{URL: "https://example.com/top.xml", Title: "Top Feed"},
+		{URL: "https://example.com/go.xml", Title: "Go", Categories: []string{"Tech"}, HTMLURL: "https://example.com/go"},
+		{URL: "https://example.com/css.xml", Title: "CSS", Categories: []string{"Tech", "Web"}},
+		{URL: "https://example.com/go.xml", Title: "Duplicate"},
+	}, opml.Subscriptions())
+	assert.Equal(t, []string{
+		"https://example.com/top.xml", "https://example.com/go.xml", "https://example.com/css.xml",
+	}, opml.FeedURLs())
+}
+
+func TestDiff(t *testing.T) {
+	oldOPML, err := NewOPMLFromBytes([]byte(`<?xml version="1.0"?><opml version="2.0"><head><title>Old</title></head>` +
+		`<body><outline text="Tech">` +
+		`<outline text="Go" type="rss" xmlUrl="https://example.com/go.xml"/>` +
+		`<outline text="CSS" type="rss" xmlUrl="https://example.com/css.xml"/>` +
+		`</outline>` +
+		`<outline text="News" type="rss" xmlUrl="https://example.com/news.xml"/>` +
+		`</body></opml>`))
+	require.NoError(t, err)
+	newOPML, err := NewOPMLFromBytes([]byte(`<?xml version="1.0"?><opml version="2.0"><head><title>New</title></head>` +
+		`<body><outline text="Tech">` +
+		`<outline text="Go" type="rss" xmlUrl="https://example.com/go.xml"/>` +
+		`<outline text="Rust" type="rss" xmlUrl="https://example.com/rust.xml"/>` +
+		`</outline>` +
+		`<outline text="Design"><outline text="CSS" type="rss" xmlUrl="https://example.com/css.xml"/></outline>` +
+		`</body></opml>`))
+	require.NoError(t, err)
+
+	changes := Diff(oldOPML, newOPML)
+	assert.Equal(t, []Subscription{
+		{URL: "https://example.com/rust.xml", Title: "Rust", Categories: []string{"Tech"}},
+	}, changes.Added)
+	assert.Equal(t, []Subscription{{URL: "https://example.com/news.xml", Title: "News"}}, changes.Removed)
+	assert.Equal(t, []MovedSubscription{{
+		Subscription:       Subscription{URL: "https://example.com/css.xml", Title: "CSS", Categories: []string{"Design"}},
+		PreviousCategories: []string{"Tech"},
+	}}, changes.Moved)
+	assert.False(t, changes.Empty())
+
+	assert.True(t, Diff(newOPML, newOPML).Empty())
+	assert.Len(t, Diff(nil, newOPML).Added, 3)
+}
+
+func TestOPMLMarshal(t *testing.T) {
+	opml := NewOPML(
+		WithTitle("Tom & Jerry's <Feeds>"),
+		WithOwnerName("Tom"),
+		WithOwnerEmail("tom@example.com"),
+		WithOutlines(*NewSubscriptionOutline("News & Views", "https://example.com/feed?a=1&b=2")),
+	)
+	opml.Head.DateCreated = *rss.NewTimestamp(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	opml.Head.DateModified = rss.Timestamp{}
+
+	data, err := opml.Marshal()
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte(xml.Header)))
+	assert.Contains(t, string(data), `<opml version="2.0">`)
+	assert.Contains(t, string(data), "<title>Tom &amp; Jerry&#39;s &lt;Feeds&gt;</title>")
+	assert.Contains(t, string(data), "<dateCreated>Fri, 02 Jan 2026 03:04:05 +0000</dateCreated>")
+	assert.NotContains(t, string(data), "dateModified")
+	assert.Contains(t, string(data), "<ownerName>Tom</ownerName>")
+	assert.Contains(t, string(data), "<ownerEmail>tom@example.com</ownerEmail>")
+	assert.Contains(t, string(data), `xmlUrl="https://example.com/feed?a=1&amp;b=2"`)
+
+	decoded, err := NewOPMLFromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, opml.Head.Title, decoded.Head.Title)
+	assert.True(t, opml.Head.DateCreated.Value.Equal(decoded.Head.DateCreated.Value))
+	assert.Equal(t, opml.FeedURLs(), decoded.FeedURLs())
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := map[string]string{
+		"  HTTPS://Example.COM:443/feed.xml#top ": "https://example.com/feed.xml",
+		"http://example.com:80":                   "http://example.com/",
+		"feed://example.com/rss":                  "http://example.com/rss",
+		"feed:https://example.com/rss":            "https://example.com/rss",
+		"example.com/feed?format=rss":             "http://example.com/feed?format=rss",
+		"":                                        "",
+	}
+	for value, want := range tests {
+		assert.Equal(t, want, NormalizeURL(value), value)
+	}
+}
+
+func TestNormalizeAndVerify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed.xml":
+			w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+			fmt.Fprint(w, `<rss version="2.0"/>`)
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html></html>`)
+		default:
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer server.Close()
+	newOPML := func() *OPML {
+		return NewOPML(WithOutlines(
+			*NewSubscriptionOutline("Live", " "+strings.ToUpper(server.URL)+"/feed.xml#latest"),
+			Outline{Text: "Folder", Outlines: []Outline{
+				*NewSubscriptionOutline("Gone", server.URL+"/gone"),
+				*NewSubscriptionOutline("Page", server.URL+"/page"),
+			}},
+		))
+	}
+
+	t.Run("normalize", func(t *testing.T) {
+		opml := newOPML()
+		results := NormalizeAndVerify(t.Context(), opml, VerifyOptions{})
+		require.Len(t, results, 3)
+		assert.Equal(t, server.URL+"/feed.xml", results[0].NormalizedURL)
+		assert.Equal(t, []string{server.URL + "/feed.xml", server.URL + "/gone", server.URL + "/page"}, opml.FeedURLs())
+	})
+
+	t.Run("annotate", func(t *testing.T) {
+		opml := newOPML()
+		results := NormalizeAndVerify(t.Context(), opml, VerifyOptions{Probe: true, Concurrency: 2})
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, "application/rss+xml", results[0].ContentType)
+		require.ErrorIs(t, results[1].Err, ErrDeadSubscription)
+		assert.Equal(t, http.StatusGone, results[1].StatusCode)
+		require.ErrorIs(t, results[2].Err, ErrDeadSubscription)
+		assert.Equal(t, "text/html", results[2].ContentType)
+		assert.Equal(t, Commented, opml.Body[1].Outlines[0].IsComment)
+		assert.Equal(t, []string{server.URL + "/feed.xml"}, opml.FeedURLs())
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		opml := newOPML()
+		NormalizeAndVerify(t.Context(), opml, VerifyOptions{Probe: true, Prune: true})
+		require.Len(t, opml.Body, 2)
+		assert.Empty(t, opml.Body[1].Outlines)
+		assert.Equal(t, []string{server.URL + "/feed.xml"}, opml.FeedURLs())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, NormalizeAndVerify(t.Context(), nil, VerifyOptions{Probe: true}))
+	})
+}
+
+func TestOPMLRoundTrip(t *testing.T) {
+	opml := NewOPML(
+		WithTitle("Subscriptions"),
+		WithOwnerName("Example Owner"),
+		WithOwnerEmail("owner@example.com"),
+		WithOutlines(
+			*NewSubscriptionOutline("Example", "https://example.com/feed.xml",
+				WithOutlineTitle("Example Feed"), WithHTMLURL("https://example.com/"), WithLanguage("en")),
+			Outline{Text: "News", Outlines: []Outline{
+				*NewSubscriptionOutline("News", "https://news.example.com/rss", WithDescription("All the news")),
+			}},
+		),
+	)
+	data, err := opml.Marshal()
+	require.NoError(t, err)
+
+	codec := conformance.Codec[*OPML]{Decode: NewOPMLFromBytes, Encode: (*OPML).Marshal}
+	require.NoError(t, conformance.RoundTrip(codec, data))
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package opml
+
+import (
+	"slices"
+	"strings"
+)
+
+// Subscription is a feed subscribed to in an OPML subscription list.
+type Subscription struct {
+	// URL is the URL of the feed (its xmlUrl).
+	URL string `json:"url"`
+	// Title is the title of the feed, or its text if it has no title.
+	Title string `json:"title,omitempty"`
+	// Categories is the path of the folders (i.e., parent outlines) the feed is in, from the outermost.
+	Categories []string `json:"categories,omitempty"`
+	// HTMLURL is the URL of the website of the feed (its htmlUrl), if any.
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// Subscriptions returns every feed subscribed to in the OPML object (i.e., each outline with an xmlUrl), in document
+// order, flattening any nested outlines. Outlines that are commented out, and those nested in them, are skipped.
+func (o *OPML) Subscriptions() []Subscription {
+	var subscriptions []Subscription
+	var walk func(outlines []Outline, path []string)
+	walk = func(outlines []Outline, path []string) {
+		for outline := range slices.Values(outlines) {
+			if outline.IsComment == Commented {
+				continue
+			}
+			if url := strings.TrimSpace(outline.XMLURL); url != "" {
+				title := strings.TrimSpace(outline.Title)
+				if title == "" {
+					title = strings.TrimSpace(outline.Text)
+				}
+				subscriptions = append(subscriptions, Subscription{
+					URL:        url,
+					Title:      title,
+					Categories: slices.Clone(path),
+					HTMLURL:    strings.TrimSpace(outline.HTMLURL),
+				})
+			}
+			if len(outline.Outlines) > 0 {
+				walk(outline.Outlines, append(slices.Clip(path), strings.TrimSpace(outline.Text)))
+			}
+		}
+	}
+	walk(o.Body, nil)
+	return subscriptions
+}
+
+// FeedURLs returns the URL of every feed subscribed to in the OPML object, in document order and without duplicates.
+// See Subscriptions.
+func (o *OPML) FeedURLs() []string {
+	var urls []string
+	for subscription := range slices.Values(o.Subscriptions()) {
+		if !slices.Contains(urls, subscription.URL) {
+			urls = append(urls, subscription.URL)
+		}
+	}
+	return urls
+}
+
+// SubscriptionChanges are the changes between two OPML subscription lists, see Diff.
+type SubscriptionChanges struct {
+	// Added are the subscriptions in the current list that are not in the previous list.
+	Added []Subscription `json:"added,omitempty"`
+	// Removed are the subscriptions in the previous list that are not in the current list.
+	Removed []Subscription `json:"removed,omitempty"`
+	// Moved are the subscriptions in both lists that are in a different folder in the current list.
+	Moved []MovedSubscription `json:"moved,omitempty"`
+}
+
+// MovedSubscription is a subscription that has moved to a different folder, see Diff.
+type MovedSubscription struct {
+	// Subscription is the subscription, as in the current list.
+	Subscription
+	// PreviousCategories is the path of the folders the subscription was in, in the previous list.
+	PreviousCategories []string `json:"previous_categories,omitempty"`
+}
+
+// Empty reports whether there are no changes.
+func (c *SubscriptionChanges) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Moved) == 0
+}
+
+// Diff compares the subscriptions of a previous and current OPML subscription list, such as a local subscription store
+// and a fresh export of it, returning the subscriptions that were added, removed and moved to a different folder.
+// Subscriptions are matched by their URL; where a URL is subscribed to more than once in a list, only the first is
+// compared. Either list may be nil, in which case it is treated as empty.
+func Diff(previous, current *OPML) *SubscriptionChanges {
+	oldSubscriptions := uniqueSubscriptions(previous)
+	newSubscriptions := uniqueSubscriptions(current)
+
+	changes := &SubscriptionChanges{}
+	for subscription := range slices.Values(newSubscriptions) {
+		idx := slices.IndexFunc(oldSubscriptions, func(s Subscription) bool { return s.URL == subscription.URL })
+		switch {
+		case idx < 0:
+			changes.Added = append(changes.Added, subscription)
+		case !slices.Equal(oldSubscriptions[idx].Categories, subscription.Categories):
+			changes.Moved = append(changes.Moved, MovedSubscription{
+				Subscription:       subscription,
+				PreviousCategories: oldSubscriptions[idx].Categories,
+			})
+		}
+	}
+	for subscription := range slices.Values(oldSubscriptions) {
+		if !slices.ContainsFunc(newSubscriptions, func(s Subscription) bool { return s.URL == subscription.URL }) {
+			changes.Removed = append(changes.Removed, subscription)
+		}
+	}
+	return changes
+}
+
+// uniqueSubscriptions returns the subscriptions of the given OPML object, keeping only the first subscription to each
+// URL. If the object is nil, no subscriptions are returned.
+func uniqueSubscriptions(o *OPML) []Subscription {
+	if o == nil {
+		return nil
+	}
+	var subscriptions []Subscription
+	for subscription := range slices.Values(o.Subscriptions()) {
+		if !slices.ContainsFunc(subscriptions, func(s Subscription) bool { return s.URL == subscription.URL }) {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions
+}
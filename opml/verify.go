@@ -0,0 +1,238 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package opml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// DefaultVerifyConcurrency is the number of subscriptions NormalizeAndVerify probes at once, if no other concurrency is
+// given.
+const DefaultVerifyConcurrency = 4
+
+// ErrDeadSubscription indicates a subscription could not be fetched or is no longer a feed.
+var ErrDeadSubscription = errors.New("dead subscription")
+
+// VerifyOptions are the options for NormalizeAndVerify.
+type VerifyOptions struct {
+	// Client is used to probe subscriptions. If it is nil, a default client is used.
+	Client *resty.Client
+	// Concurrency is the number of subscriptions probed at once. If it is not positive, DefaultVerifyConcurrency is used.
+	Concurrency int
+	// Probe requests each subscription to check that it is live and is served as a feed.
+	Probe bool
+	// Prune removes dead subscriptions from the OPML object, rather than commenting them out.
+	Prune bool
+}
+
+// VerifyResult is the result of normalizing, and optionally probing, a single subscription.
+type VerifyResult struct {
+	// Err is non-nil if the subscription was probed and found to be dead. It wraps ErrDeadSubscription.
+	Err error
+	// URL is the xmlUrl of the subscription, as it was before normalization.
+	URL string
+	// NormalizedURL is the xmlUrl of the subscription after normalization.
+	NormalizedURL string
+	// Title is the title of the subscription, or its text if it has no title.
+	Title string
+	// ContentType is the media type the subscription was served as, if it was probed.
+	ContentType string
+	// StatusCode is the HTTP status code of the response to probing the subscription, if any.
+	StatusCode int
+}
+
+// NormalizeAndVerify cleans up the subscriptions of the OPML object, the usual step after importing an old export. The
+// xmlUrl of every subscription is normalized with NormalizeURL. If opts.Probe is set, each subscription is then
+// requested, with bounded concurrency, and any that cannot be fetched, respond with an error status or are not served as
+// XML or JSON are dead. Dead subscriptions are commented out (with isComment="true"), or removed if opts.Prune is set.
+// Outlines that are already commented out are left as is.
+//
+// A result is returned for each subscription, in document order. Subscriptions not probed before the context was
+// canceled are dead, with the context error. A nil OPML object has no subscriptions, so no results are returned.
+func NormalizeAndVerify(ctx context.Context, o *OPML, opts VerifyOptions) []VerifyResult {
+	if o == nil {
+		return nil
+	}
+	outlines := subscriptionOutlines(o.Body)
+	results := make([]VerifyResult, len(outlines))
+	for idx, outline := range outlines {
+		results[idx] = VerifyResult{
+			URL:   outline.XMLURL,
+			Title: strings.TrimSpace(outline.Title),
+		}
+		if results[idx].Title == "" {
+			results[idx].Title = strings.TrimSpace(outline.Text)
+		}
+		outline.XMLURL = NormalizeURL(outline.XMLURL)
+		results[idx].NormalizedURL = outline.XMLURL
+	}
+	if !opts.Probe {
+		return results
+	}
+
+	probeSubscriptions(ctx, results, opts)
+
+	dead := make(map[*Outline]bool)
+	for idx, outline := range outlines {
+		if results[idx].Err == nil {
+			continue
+		}
+		if opts.Prune {
+			dead[outline] = true
+		} else {
+			outline.IsComment = Commented
+		}
+	}
+	if len(dead) > 0 {
+		o.Body = pruneOutlines(o.Body, dead)
+	}
+	return results
+}
+
+// NormalizeURL returns the normalized form of the given feed URL. Surrounding whitespace is trimmed, the feed: pseudo
+// scheme is replaced with http: (unless it wraps another URL) and URLs without a scheme are assumed to be http:. The
+// scheme and host are lowercased, default ports and fragments are removed, and an empty path becomes "/". The query is
+// left as is, as feeds are often identified by it. Values that cannot be parsed as a URL are returned trimmed.
+func NormalizeURL(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return value
+	}
+	if rest, ok := cutPrefixFold(value, "feed:"); ok {
+		if strings.HasPrefix(rest, "//") {
+			value = "http:" + rest
+		} else {
+			value = rest
+		}
+	}
+	if !strings.Contains(value, "://") {
+		value = "http://" + strings.TrimPrefix(value, "//")
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return value
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = u.Hostname()
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.Fragment, u.RawFragment = "", ""
+	return u.String()
+}
+
+// cutPrefixFold is strings.CutPrefix, but the prefix is matched case-insensitively.
+func cutPrefixFold(value, prefix string) (string, bool) {
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return value, false
+	}
+	return value[len(prefix):], true
+}
+
+// subscriptionOutlines returns every outline with an xmlUrl in the given outlines, and those nested in them, skipping
+// any outlines that are commented out.
+func subscriptionOutlines(outlines []Outline) []*Outline {
+	var found []*Outline
+	for idx := range outlines {
+		outline := &outlines[idx]
+		if outline.IsComment == Commented {
+			continue
+		}
+		if strings.TrimSpace(outline.XMLURL) != "" {
+			found = append(found, outline)
+		}
+		found = append(found, subscriptionOutlines(outline.Outlines)...)
+	}
+	return found
+}
+
+// pruneOutlines returns the given outlines without those in dead, recursively.
+func pruneOutlines(outlines []Outline, dead map[*Outline]bool) []Outline {
+	pruned := make([]Outline, 0, len(outlines))
+	for idx := range outlines {
+		if dead[&outlines[idx]] {
+			continue
+		}
+		outline := outlines[idx]
+		if len(outline.Outlines) > 0 {
+			outline.Outlines = pruneOutlines(outlines[idx].Outlines, dead)
+		}
+		pruned = append(pruned, outline)
+	}
+	return pruned
+}
+
+// probeSubscriptions probes the normalized URL of each of the given results concurrently, recording the outcome in the
+// result.
+func probeSubscriptions(ctx context.Context, results []VerifyResult, opts VerifyOptions) {
+	client := opts.Client
+	if client == nil {
+		client = types.NewClient()
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultVerifyConcurrency
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(results)) {
+		wg.Go(func() {
+			for idx := range indexes {
+				probeSubscription(ctx, client, &results[idx])
+			}
+		})
+	}
+	for idx := range results {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// probeSubscription requests the normalized URL of the given result, recording the status code and content type of the
+// response, and an ErrDeadSubscription error if the subscription is dead.
+func probeSubscription(ctx context.Context, client *resty.Client, result *VerifyResult) {
+	if err := ctx.Err(); err != nil {
+		result.Err = fmt.Errorf("%w: %w", ErrDeadSubscription, err)
+		return
+	}
+	resp, err := client.R().SetContext(ctx).SetDoNotParseResponse(true).Get(result.NormalizedURL)
+	if err != nil {
+		result.Err = fmt.Errorf("%w: %w", ErrDeadSubscription, err)
+		return
+	}
+	defer resp.RawBody().Close()
+
+	result.StatusCode = resp.StatusCode()
+	result.ContentType, _, _ = mime.ParseMediaType(resp.Header().Get("Content-Type"))
+	switch {
+	case resp.IsError():
+		result.Err = fmt.Errorf("%w: %s", ErrDeadSubscription, resp.Status())
+	case !isFeedContentType(result.ContentType):
+		result.Err = fmt.Errorf("%w: served as %q", ErrDeadSubscription, result.ContentType)
+	}
+}
+
+// isFeedContentType reports whether the given media type could be that of a feed, i.e., any XML or JSON media type.
+func isFeedContentType(mediaType string) bool {
+	return slices.ContainsFunc([]string{"/xml", "+xml", "/json", "+json"}, func(suffix string) bool {
+		return strings.HasSuffix(mediaType, suffix)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package opml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// Marshal encodes the OPML object as an OPML 2.0 document, with an XML declaration. See Write.
+func (o *OPML) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := o.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write writes the OPML object to the given io.Writer as an indented OPML 2.0 document, with an XML declaration. The
+// <dateCreated> and <dateModified> elements of the head are written in RFC 822 format, and omitted if not set. If the
+// object has no version, it is written as version 2.0.
+func (o *OPML) Write(w io.Writer) error {
+	root := *o
+	if root.Version == "" {
+		root.Version = "2.0"
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("could not write OPML: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.EncodeElement(root, xml.StartElement{Name: xml.Name{Local: "opml"}}); err != nil {
+		return fmt.Errorf("could not encode OPML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("could not encode OPML: %w", err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("could not write OPML: %w", err)
+	}
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler. The <dateCreated> and <dateModified> elements are optional, so any without a
+// value are omitted rather than failing to encode.
+func (h Head) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	// head has the fields of Head but not this method, to avoid recursion.
+	type head Head
+	shadow := struct {
+		head
+
+		DateCreated  *rss.Timestamp `xml:"dateCreated,omitempty"`
+		DateModified *rss.Timestamp `xml:"dateModified,omitempty"`
+	}{head: head(h)}
+	if !h.DateCreated.Value.IsZero() {
+		shadow.DateCreated = &h.DateCreated
+	}
+	if !h.DateModified.Value.IsZero() {
+		shadow.DateModified = &h.DateModified
+	}
+	if err := enc.EncodeElement(shadow, start); err != nil {
+		return fmt.Errorf("head: marshal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/immanent-tech/go-syndication/feedstate"
+	"github.com/immanent-tech/go-syndication/sanitization"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// Option is a functional option applied when creating a Feed. Options control how the feed data is decoded and how
+// values are presented through the generic Feed and Item types.
+type Option func(*config)
+
+// config holds the configuration of a Feed.
+type config struct {
+	imageURLRewriter  func(string) string
+	policy            *bluemonday.Policy
+	client            *resty.Client
+	logger            *slog.Logger
+	timeout           time.Duration
+	staleAge          time.Duration
+	detectLanguage    bool
+	dedupeDescription bool
+	validate          bool
+	maxItems          int
+	maxTokens         int
+	maxBytes          int64
+	itemHistory       int
+	maxPages          int
+	rawSource         bool
+	inheritFeed       bool
+	insecureTLS       bool
+	repair            bool
+	urlSchemes        []string
+	fetchState        *feedstate.State
+	sanitization      *atomic.Int64
+}
+
+// newConfig creates a config with the given options applied.
+func newConfig(options ...Option) *config {
+	cfg := &config{sanitization: new(atomic.Int64)}
+	for option := range slices.Values(options) {
+		option(cfg)
+	}
+	return cfg
+}
+
+// WithFeedInheritance option fills in the authors and rights of items that don't declare their own from those of the
+// feed, as Atom requires for authors. Item.GetAuthors and Item.GetRights will then return the values of the feed when
+// the item has none. Items always inherit the language of the feed, see Item.GetLanguage.
+func WithFeedInheritance() Option {
+	return func(c *config) {
+		c.inheritFeed = true
+	}
+}
+
+// WithLanguageDetection option enables language detection for items. When neither an item nor its feed declares a
+// language, Item.GetLanguage will attempt to detect the language from the title and content of the item.
+func WithLanguageDetection() Option {
+	return func(c *config) {
+		c.detectLanguage = true
+	}
+}
+
+// WithValidation option will validate the feed when it is decoded, returning an error if validation fails. By default,
+// no validation is performed when decoding and Feed.Validate can be called on demand.
+func WithValidation() Option {
+	return func(c *config) {
+		c.validate = true
+	}
+}
+
+// WithMaxItems option will stop decoding a feed once the given number of items have been decoded. For XML feeds, the
+// remainder of the data is not read at all, which makes this option useful for previewing or verifying potentially huge
+// feeds. JSON feeds are decoded in full and then truncated. A value of zero or less means no limit.
+func WithMaxItems(n int) Option {
+	return func(c *config) {
+		c.maxItems = n
+	}
+}
+
+// WithMaxBytes option sets a budget for the number of bytes read when decoding a feed. If the feed data is larger than
+// the budget, decoding fails with an ErrFeedTooLarge error. A value of zero or less means no limit.
+func WithMaxBytes(n int64) Option {
+	return func(c *config) {
+		c.maxBytes = n
+	}
+}
+
+// WithMaxTokens option sets a budget for the number of XML tokens (elements, text, comments, etc.) read when decoding a
+// feed. This guards against feeds that are small in size but expensive to decode, such as deeply nested or highly
+// fragmented documents. If the budget is exceeded, decoding fails with an ErrFeedTooLarge error. It has no effect on
+// JSON feeds. A value of zero or less means no limit.
+func WithMaxTokens(n int) Option {
+	return func(c *config) {
+		c.maxTokens = n
+	}
+}
+
+// WithItemHistory option sets the maximum number of items kept by Feed.Refresh. Items that have rotated out of the
+// fetched feed are kept, in their existing order, until the Feed holds this many items. Items in the fetched feed are
+// always kept, even if there are more of them than the limit. By default, DefaultItemHistory items are kept.
+func WithItemHistory(n int) Option {
+	return func(c *config) {
+		c.itemHistory = n
+	}
+}
+
+// WithMaxPages option sets the maximum number of pages fetched by FetchAllPages, including the first. By default,
+// DefaultMaxPages pages are fetched.
+func WithMaxPages(n int) Option {
+	return func(c *config) {
+		c.maxPages = n
+	}
+}
+
+// WithRawSource option retains the raw data of the feed when it is decoded, available from Feed.Raw for the whole
+// document and Item.Raw for each item, such as for auditing, debugging feeds that are decoded incorrectly or passing
+// untouched markup to other processors. The whole document is held in memory. The raw data of XML items is only
+// available for UTF-8 encoded documents. It has no effect on DecodeItems.
+func WithRawSource() Option {
+	return func(c *config) {
+		c.rawSource = true
+	}
+}
+
+// WithDescriptionDeduplication option will collapse the description of an item into its content when both are (near)
+// duplicates of each other, as determined by IsDuplicateContent. In that case, Item.GetDescription will return an empty
+// string, so that the item text is only shown once.
+func WithDescriptionDeduplication() Option {
+	return func(c *config) {
+		c.dedupeDescription = true
+	}
+}
+
+// WithImageURLRewriter option sets a function that will rewrite the URL of every image returned from the Feed and its
+// items. It is applied to the result of GetImage on both the Feed and Item, as well as any images in the description
+// and content of an Item. This can be used to route images through a proxy or caching service.
+func WithImageURLRewriter(rewriter func(string) string) Option {
+	return func(c *config) {
+		c.imageURLRewriter = rewriter
+	}
+}
+
+// WithSanitizationPolicy option sets a bluemonday policy that is applied to the description and content of every Item.
+// Values are always sanitized with a default policy by the source types, so this option can only further restrict what
+// is output, for example, by using bluemonday.StrictPolicy to remove all HTML. Any URL schemes set with the
+// WithURLSchemes option still apply.
+func WithSanitizationPolicy(policy *bluemonday.Policy) Option {
+	return func(c *config) {
+		c.policy = policy
+	}
+}
+
+// WithURLSchemes option restricts the URL schemes accepted for links to the given schemes (e.g., "https"). Feed.Validate
+// reports any links of the Feed and its items with another scheme, links and images with another scheme are removed
+// from the description and content of an Item, and images with another scheme are not rewritten by the
+// WithImageURLRewriter option. Relative URLs are always accepted. Schemes are case-insensitive. By default, links with
+// any scheme are valid, and the http, https and mailto links allowed by the default sanitization policy are kept.
+func WithURLSchemes(schemes ...string) Option {
+	return func(c *config) {
+		c.urlSchemes = nil
+		for scheme := range slices.Values(schemes) {
+			c.urlSchemes = append(c.urlSchemes, strings.ToLower(strings.TrimSpace(scheme)))
+		}
+	}
+}
+
+// WithRepair option repairs common breakage in XML feeds before they are decoded, so that feeds that would otherwise
+// fail to decode can be recovered: ampersands that do not start a reference are escaped, HTML entities (e.g., &nbsp;)
+// are replaced with references to their characters, characters that are not allowed in XML are removed, and encoding
+// declarations that do not match the encoding of the document are corrected. The repairs made are available from
+// Feed.Repairs. The whole document is held in memory, and any raw data retained with WithRawSource is of the repaired
+// document. It has no effect on JSONFeed.
+func WithRepair() Option {
+	return func(c *config) {
+		c.repair = true
+	}
+}
+
+// WithClient option sets the HTTP client used by NewFeedFromURL to fetch a feed. By default, a new client is created for
+// each fetch.
+func WithClient(client *resty.Client) Option {
+	return func(c *config) {
+		c.client = client
+	}
+}
+
+// WithInsecureTLS option disables verification of the TLS certificate of the server when fetching a feed, so that
+// feeds served with a self-signed or otherwise invalid certificate (e.g., on an intranet) can be fetched. This is
+// insecure: the connection can be intercepted by anyone able to present a certificate. The certificate is still
+// verified after the fetch, and CheckHealth reports any error, see TLSInfo. The option only applies to the call it is
+// passed to, and is not kept for a later Feed.Refresh. It also only applies to the default client; a client set with
+// the WithClient option must be configured to skip verification itself.
+func WithInsecureTLS() Option {
+	return func(c *config) {
+		c.insecureTLS = true
+	}
+}
+
+// WithTimeout option sets a deadline for NewFeedFromURL to fetch and decode a feed. A value of zero or less means no
+// deadline other than any set on the context.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+	}
+}
+
+// WithStaleAge option sets how old the newest item of a feed can be before CheckHealth classifies the feed as stale.
+// A value of zero or less means DefaultStaleAge is used.
+func WithStaleAge(age time.Duration) Option {
+	return func(c *config) {
+		c.staleAge = age
+	}
+}
+
+// WithFetchState option sets the fetch state of the feed, which is used and updated by NewFeedFromURL and Feed.Refresh.
+// If the state holds an ETag or Last-Modified value from a previous fetch of the same URL, a conditional request is
+// made, and if the server reports the feed has not been modified, NewFeedFromURL returns ErrNotModified. The outcome of
+// the fetch is recorded in the state, so that it can be persisted and used to schedule the next fetch.
+func WithFetchState(state *feedstate.State) Option {
+	return func(c *config) {
+		c.fetchState = state
+	}
+}
+
+// WithLogger option sets the logger used to report progress when fetching a feed with NewFeedFromURL. By default,
+// slog.Default is used.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// with returns a copy of the config with the given options applied. The config may be nil.
+func (c *config) with(options ...Option) *config {
+	cfg := &config{}
+	if c != nil {
+		*cfg = *c
+	}
+	for option := range slices.Values(options) {
+		option(cfg)
+	}
+	return cfg
+}
+
+// httpClient returns the configured HTTP client, or a new default client if none is configured, which skips verifying
+// the TLS certificate of the server if WithInsecureTLS was used.
+func (c *config) httpClient() *resty.Client {
+	if c.client != nil {
+		return c.client
+	}
+	client := types.NewClient()
+	if c.insecureTLS {
+		// The certificate is verified after the fetch instead, see newTLSInfo.
+		client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+	return client
+}
+
+// sanitize applies any configured sanitization policy and URL schemes to the given content.
+func (c *config) sanitize(content string) string {
+	if c == nil || (c.policy == nil && len(c.urlSchemes) == 0) || content == "" {
+		return content
+	}
+	options := []sanitization.Option{sanitization.WithURLSchemes(c.urlSchemes...)}
+	if c.policy != nil {
+		options = append(options, sanitization.WithPolicy(c.policy))
+	}
+	return sanitization.SanitizeString(content, options...)
+}
+
+// allowedURL reports whether the given URL is relative or has a scheme accepted by the WithURLSchemes option. Any URL is
+// allowed if the option was not used.
+func (c *config) allowedURL(rawURL string) bool {
+	if c == nil || len(c.urlSchemes) == 0 {
+		return true
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "" || slices.Contains(c.urlSchemes, strings.ToLower(u.Scheme)))
+}
+
+// rewriteImage returns a copy of the given image with its URL rewritten by any configured rewriter. URLs with a scheme
+// that is not accepted by the WithURLSchemes option are not rewritten.
+func (c *config) rewriteImage(img *types.ImageInfo) *types.ImageInfo {
+	if c == nil || c.imageURLRewriter == nil || img == nil || img.URL == "" || !c.allowedURL(img.URL) {
+		return img
+	}
+	return &types.ImageInfo{
+		URL:   c.imageURLRewriter(img.URL),
+		Title: img.Title,
+	}
+}
+
+// rewriteContentImages rewrites the URL of any images in the given HTML content with any configured rewriter. URLs with
+// a scheme that is not accepted by the WithURLSchemes option are not rewritten.
+func (c *config) rewriteContentImages(content string) string {
+	if c == nil || c.imageURLRewriter == nil || content == "" {
+		return content
+	}
+	return sanitization.RewriteImageURLs(content, func(imageURL string) string {
+		if !c.allowedURL(imageURL) {
+			return imageURL
+		}
+		return c.imageURLRewriter(imageURL)
+	})
+}
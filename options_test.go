@@ -0,0 +1,141 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+	"github.com/immanent-tech/go-syndication/validation"
+)
+
+func TestWithImageURLRewriter(t *testing.T) {
+	source := &rss.RSS{
+		Channel: rss.Channel{
+			Title: "Test",
+			Image: &rss.Image{URL: "https://example.com/logo.png", Title: "Logo"},
+			Items: []rss.Item{
+				*rss.NewItem(
+					rss.WithItemTitle("Item"),
+					rss.WithItemImage(&types.ImageInfo{URL: "https://example.com/a.jpg"}),
+					rss.WithItemDescription(`<img src="https://example.com/b.jpg"/>`, false),
+					rss.WithItemContent(`<img src="https://example.com/c.jpg"/>`, false),
+				),
+			},
+		},
+	}
+	rewriter := func(url string) string {
+		return "https://proxy.example.org/?url=" + url
+	}
+
+	feed := NewFeedFromSource(source, WithImageURLRewriter(rewriter))
+	assert.Equal(t, &types.ImageInfo{URL: "https://proxy.example.org/?url=https://example.com/logo.png", Title: "Logo"},
+		feed.GetImage())
+	items := feed.GetItems()
+	require.Len(t, items, 1)
+	assert.Equal(t, "https://proxy.example.org/?url=https://example.com/a.jpg", items[0].GetImage().URL)
+	assert.Contains(t, items[0].GetDescription(), `src="https://proxy.example.org/?url=https://example.com/b.jpg"`)
+	require.NotNil(t, items[0].GetContent())
+	assert.Contains(t, *items[0].GetContent(), `src="https://proxy.example.org/?url=https://example.com/c.jpg"`)
+	// The source should be unchanged.
+	assert.Equal(t, "https://example.com/logo.png", feed.FeedSource.GetImage().URL)
+
+	// Without the option, images should be unchanged.
+	feed = NewFeedFromSource(source)
+	assert.Equal(t, "https://example.com/logo.png", feed.GetImage().URL)
+	assert.Equal(t, "https://example.com/a.jpg", feed.GetItems()[0].GetImage().URL)
+}
+
+func TestWithValidation(t *testing.T) {
+	invalid := `<?xml version="1.0"?>
+<rss version="2.0">
+  <channel>
+    <title>Invalid</title>
+    <link>https://example.com/</link>
+    <description>A feed with an invalid item</description>
+    <item><link>https://example.com/1</link></item>
+  </channel>
+</rss>`
+
+	feed, err := NewDecoder[*rss.RSS](strings.NewReader(invalid), WithValidation())
+	require.ErrorIs(t, err, ErrParseBytes)
+	assert.Nil(t, feed)
+
+	feed, err = NewDecoder[*rss.RSS](strings.NewReader(invalid))
+	require.NoError(t, err)
+	err = feed.Validate()
+	require.Error(t, err)
+	assert.Equal(t, err, feed.Validate())
+
+	feed, err = NewDecoder[*rss.RSS](strings.NewReader(streamTests[types.SourceTypeRSS]), WithValidation())
+	require.NoError(t, err)
+	assert.NoError(t, feed.Validate())
+}
+
+func TestWithURLSchemes(t *testing.T) {
+	data := `<rss version="2.0"><channel><title>FTP</title><link>https://example.com/</link>` +
+		`<description>A feed with an FTP link</description>` +
+		`<item><title>Item</title><link>ftp://example.com/file</link>` +
+		`<description><![CDATA[<a href="ftp://example.com/file">file</a> <a href="mailto:a@example.com">mail</a>]]>` +
+		`</description></item></channel></rss>`
+
+	// By default, any scheme is accepted.
+	feed, err := NewDecoder[*rss.RSS](strings.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, feed.Validate())
+	assert.Contains(t, feed.GetItems()[0].GetDescription(), `href="mailto:a@example.com"`)
+
+	feed, err = NewDecoder[*rss.RSS](strings.NewReader(data), WithURLSchemes("HTTPS"))
+	require.NoError(t, err)
+	err = feed.Validate()
+	require.Error(t, err)
+	structErr, ok := errors.AsType[*validation.StructError](err)
+	require.True(t, ok)
+	require.Len(t, structErr.Fields, 1)
+	assert.Equal(t, "Feed.Items[0].Link", structErr.Fields[0].Namespace)
+	assert.Equal(t, "file mail", feed.GetItems()[0].GetDescription())
+
+	feed, err = NewDecoder[*rss.RSS](strings.NewReader(data), WithURLSchemes("https", "ftp"))
+	require.NoError(t, err)
+	assert.NoError(t, feed.Validate())
+
+	// The URL schemes also apply with a custom sanitization policy.
+	policy := bluemonday.UGCPolicy().AllowURLSchemes("ftp")
+	feed, err = NewDecoder[*rss.RSS](strings.NewReader(data), WithSanitizationPolicy(policy), WithURLSchemes("https"))
+	require.NoError(t, err)
+	assert.Equal(t, "file mail", feed.GetItems()[0].GetDescription())
+}
+
+func TestWithSanitizationPolicy(t *testing.T) {
+	source := &rss.RSS{
+		Channel: rss.Channel{
+			Title: "Test",
+			Items: []rss.Item{
+				*rss.NewItem(
+					rss.WithItemTitle("Item"),
+					rss.WithItemDescription(`<p>Some <b>bold</b> text.</p>`, false),
+					rss.WithItemContent(`<p>Some <a href="https://example.com/">linked</a> text.</p>`, false),
+				),
+			},
+		},
+	}
+
+	feed := NewFeedFromSource(source, WithSanitizationPolicy(bluemonday.StrictPolicy()))
+	items := feed.GetItems()
+	require.Len(t, items, 1)
+	assert.Equal(t, "Some bold text.", items[0].GetDescription())
+	require.NotNil(t, items[0].GetContent())
+	assert.Equal(t, "Some linked text.", *items[0].GetContent())
+
+	// Without the option, the default policy should be used.
+	feed = NewFeedFromSource(source)
+	assert.Contains(t, feed.GetItems()[0].GetDescription(), "<b>bold</b>")
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// linkRelPrev is the HTML form of the "previous" link relation, which is also used by some feeds.
+const linkRelPrev = "prev"
+
+// DefaultMaxPages is the maximum number of pages fetched by FetchAllPages, unless changed with the WithMaxPages option.
+const DefaultMaxPages = 50
+
+// NextPageURL returns the URL of the next page of a paginated Feed: the next_url of a JSONFeed, or the link with a
+// "next" relation of an Atom (RFC 5005) or RSS feed. A relative URL is resolved against the source URL of the Feed. If
+// the Feed has no next page, an empty string is returned.
+func (f *Feed) NextPageURL() string {
+	return f.pageURL(LinkRelNext)
+}
+
+// GetPagination returns the links between the pages of a paginated Feed, regardless of its format: the links with
+// "first", "last", "next" and "previous" (or "prev") relations of an Atom (RFC 5005) or RSS feed, or the next_url of a
+// JSONFeed. Relative URLs are resolved against the source URL of the Feed. Links the Feed does not have are empty.
+func (f *Feed) GetPagination() types.Pagination {
+	return types.Pagination{
+		First:    f.pageURL(LinkRelFirst),
+		Last:     f.pageURL(LinkRelLast),
+		Next:     f.pageURL(LinkRelNext),
+		Previous: cmp.Or(f.pageURL(LinkRelPrevious), f.pageURL(linkRelPrev)),
+	}
+}
+
+// pageURL returns the URL of the first link of the Feed with the given relation, resolved against the source URL of
+// the Feed, or an empty string if there is no such link.
+func (f *Feed) pageURL(rel string) string {
+	links := f.GetLinksByRel(rel)
+	if len(links) == 0 {
+		return ""
+	}
+	return resolveURL(f.GetSourceURL(), links[0].Href)
+}
+
+// FetchAllPages fetches the paginated feed at the given URL, following the next page of each page (see
+// Feed.NextPageURL) and appending its items to the Feed of the first page, in order. Pages are followed until one has
+// no next page, a page would be fetched again or the maximum number of pages has been fetched (see WithMaxPages).
+// Options are applied to the fetch of every page, except that any WithFetchState option only applies to the first.
+//
+// Every page must be of the same format as the first. If any page cannot be fetched or decoded, an error is returned.
+func FetchAllPages(ctx context.Context, feedURL string, options ...Option) (*Feed, error) {
+	cfg := newConfig(options...)
+	maxPages := cfg.maxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	feed, err := fetchFeed(ctx, feedURL, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fetch state tracks a single URL, so it is not used for the pages after the first.
+	pageCfg := cfg.with()
+	pageCfg.fetchState = nil
+	fetched := map[string]bool{feedURL: true}
+	page, pageURL := feed, feedURL
+	for pages := 1; pages < maxPages; pages++ {
+		links := page.GetLinksByRel(LinkRelNext)
+		if len(links) == 0 {
+			break
+		}
+		nextURL := resolveURL(pageURL, links[0].Href)
+		if fetched[nextURL] {
+			break
+		}
+		fetched[nextURL] = true
+
+		page, err = fetchFeed(ctx, nextURL, pageCfg)
+		if err != nil {
+			return nil, fmt.Errorf("fetch page %d: %w", pages+1, err)
+		}
+		if page.SourceType != feed.SourceType {
+			return nil, fmt.Errorf("%w: page %d is %s, not %s", ErrFetch, pages+1, page.SourceType, feed.SourceType)
+		}
+		for item := range page.FeedSource.ItemsSeq() {
+			if err := feed.AddItem(item); err != nil {
+				return nil, fmt.Errorf("%w: page %d: %w", ErrFetch, pages+1, err)
+			}
+		}
+		pageURL = nextURL
+	}
+	return feed, nil
+}
+
+// Backfill reconstructs the history of the feed at the given URL, by fetching it and then walking backwards through its
+// older documents: the "prev-archive" link of an archived feed (RFC 5005), or if there is none, the next page of a
+// paginated feed (see Feed.NextPageURL), as some RSS feeds are paged instead. Documents are followed until one has no
+// older document, a document would be fetched again, the maximum number of documents has been fetched (see
+// WithMaxPages) or at least maxItems items have been found. A maxItems of zero or less means no limit on the number of
+// items.
+//
+// The items of all the documents are merged, with items of newer documents taking precedence over items with the same
+// key in older ones (see Item.Key). At most maxItems of the newest items are returned, sorted in chronological order by
+// their published date, with any items without a date last. Options are applied to the fetch of every document,
+// except that any WithFetchState option only applies to the first. If any document cannot be fetched or decoded, an
+// error is returned.
+func Backfill(ctx context.Context, feedURL string, maxItems int, options ...Option) ([]Item, error) {
+	cfg := newConfig(options...)
+	maxPages := cfg.maxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	feed, err := fetchFeed(ctx, feedURL, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fetch state tracks a single URL, so it is not used for the documents after the first.
+	pageCfg := cfg.with()
+	pageCfg.fetchState = nil
+	fetched := map[string]bool{feedURL: true}
+	seen := make(map[string]bool)
+	var items []Item
+	page, pageURL := feed, feedURL
+	for pages := 1; ; pages++ {
+		for item := range page.ItemsSeq() {
+			if key := item.Key(); !seen[key] {
+				seen[key] = true
+				items = append(items, item)
+			}
+		}
+		if (maxItems > 0 && len(items) >= maxItems) || pages >= maxPages {
+			break
+		}
+		olderURL := olderDocumentURL(page, pageURL)
+		if olderURL == "" || fetched[olderURL] {
+			break
+		}
+		fetched[olderURL] = true
+
+		page, err = fetchFeed(ctx, olderURL, pageCfg)
+		if err != nil {
+			return nil, fmt.Errorf("fetch archive %d: %w", pages+1, err)
+		}
+		pageURL = olderURL
+	}
+
+	if maxItems > 0 && len(items) > maxItems {
+		items = items[:maxItems]
+	}
+	SortItems(items, SortByPublished, false)
+	return items, nil
+}
+
+// olderDocumentURL returns the URL of the document of the given feed with older items than it: its "prev-archive"
+// link, or if it has none, its "next" link. A relative URL is resolved against the given URL of the page. If there is
+// no older document, an empty string is returned.
+func olderDocumentURL(page *Feed, pageURL string) string {
+	links := page.GetLinksByRel(LinkRelPrevArchive)
+	if len(links) == 0 {
+		links = page.GetLinksByRel(LinkRelNext)
+	}
+	if len(links) == 0 {
+		return ""
+	}
+	return resolveURL(pageURL, links[0].Href)
+}
+
+// resolveURL resolves the given reference, which may be a relative URL, against the given base URL. If either cannot
+// be parsed, the reference is returned as is.
+func resolveURL(base, ref string) string {
+	baseURL, err := url.Parse(base)
+	if err != nil || base == "" {
+		return ref
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return baseURL.ResolveReference(refURL).String()
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func newPaginatedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	jsonPages := map[string]string{
+		"/feed.json":   `,"next_url":"{base}/page2.json"`,
+		"/page2.json":  `,"next_url":"page3.json"`,
+		"/page3.json":  `,"next_url":"{base}/feed.json"`,
+		"/single.json": ``,
+	}
+	var server *httptest.Server
+	for path, next := range jsonPages {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/feed+json")
+			_, _ = fmt.Fprintf(w, `{"version":"https://jsonfeed.org/version/1.1","title":"Paged"%s,`+
+				`"items":[{"id":"%[2]s","title":"Item %[2]s"}]}`, strings.ReplaceAll(next, "{base}", server.URL), path)
+		})
+	}
+	mux.HandleFunc("/feed.atom", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		_, _ = fmt.Fprintf(w, `<feed xmlns="http://www.w3.org/2005/Atom"><title>Paged</title>`+
+			`<link rel="next" href="%s/page2.atom"/><entry><title>First</title></entry></feed>`, server.URL)
+	})
+	mux.HandleFunc("/page2.atom", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		_, _ = fmt.Fprint(w, `<feed xmlns="http://www.w3.org/2005/Atom"><title>Paged</title>`+
+			`<link rel="previous" href="feed.atom"/><entry><title>Second</title></entry></feed>`)
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFetchAllPages(t *testing.T) {
+	server := newPaginatedServer(t)
+
+	t.Run("jsonfeed", func(t *testing.T) {
+		feed, err := FetchAllPages(t.Context(), server.URL+"/feed.json")
+		require.NoError(t, err)
+		assert.Equal(t, types.SourceTypeJSONFeed, feed.SourceType)
+		assert.Equal(t, server.URL+"/page2.json", feed.NextPageURL())
+		// The third page links back to the first, which is not fetched again.
+		assert.Equal(t, []string{"Item /feed.json", "Item /page2.json", "Item /page3.json"}, itemTitles(feed.GetItems()))
+	})
+	t.Run("max pages", func(t *testing.T) {
+		feed, err := FetchAllPages(t.Context(), server.URL+"/feed.json", WithMaxPages(2))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Item /feed.json", "Item /page2.json"}, itemTitles(feed.GetItems()))
+	})
+	t.Run("single page", func(t *testing.T) {
+		feed, err := FetchAllPages(t.Context(), server.URL+"/single.json")
+		require.NoError(t, err)
+		assert.Empty(t, feed.NextPageURL())
+		assert.Len(t, feed.GetItems(), 1)
+	})
+	t.Run("atom", func(t *testing.T) {
+		feed, err := FetchAllPages(t.Context(), server.URL+"/feed.atom")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"First", "Second"}, itemTitles(feed.GetItems()))
+	})
+	t.Run("missing page", func(t *testing.T) {
+		_, err := FetchAllPages(t.Context(), server.URL+"/missing.json")
+		require.ErrorIs(t, err, ErrFetch)
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	entry := func(id, title, updated string) string {
+		return fmt.Sprintf(`<entry><id>%s</id><title>%s</title><updated>%s</updated>`+
+			`<published>%[3]s</published></entry>`, id, title, updated)
+	}
+	archives := map[string]string{
+		"/feed.atom": `<link rel="prev-archive" href="archive/2.atom"/>` +
+			entry("urn:4", "Four", "2026-04-01T00:00:00Z") + entry("urn:3", "Three (edited)", "2026-03-01T00:00:00Z"),
+		"/archive/2.atom": `<link rel="prev-archive" href="1.atom"/>` +
+			entry("urn:3", "Three", "2026-03-01T00:00:00Z") + entry("urn:2", "Two", "2026-02-01T00:00:00Z"),
+		"/archive/1.atom": `<link rel="prev-archive" href="/feed.atom"/>` +
+			entry("urn:1", "One", "2026-01-01T00:00:00Z"),
+	}
+	mux := http.NewServeMux()
+	for path, body := range archives {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/atom+xml")
+			_, _ = fmt.Fprintf(w, `<feed xmlns="http://www.w3.org/2005/Atom"><title>Archived</title>%s</feed>`, body)
+		})
+	}
+	mux.HandleFunc("/feed.rss", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		next := `<atom:link rel="next" href="/feed.rss?paged=2"/>`
+		title, date := "Newer", "Thu, 02 Apr 2026 00:00:00 GMT"
+		if r.URL.Query().Get("paged") == "2" {
+			next, title, date = "", "Older", "Wed, 01 Apr 2026 00:00:00 GMT"
+		}
+		_, _ = fmt.Fprintf(w, `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">`+
+			`<channel><title>Paged</title>%s<item><title>%s</title><guid>%[2]s</guid><pubDate>%s</pubDate></item>`+
+			`</channel></rss>`, next, title, date)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	t.Run("archived", func(t *testing.T) {
+		items, err := Backfill(t.Context(), server.URL+"/feed.atom", 0)
+		require.NoError(t, err)
+		// The newest version of a repeated entry is kept, and the first archive links back to the feed, which is not
+		// fetched again.
+		assert.Equal(t, []string{"One", "Two", "Three (edited)", "Four"}, itemTitles(items))
+	})
+	t.Run("max items", func(t *testing.T) {
+		items, err := Backfill(t.Context(), server.URL+"/feed.atom", 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Two", "Three (edited)", "Four"}, itemTitles(items))
+	})
+	t.Run("max pages", func(t *testing.T) {
+		items, err := Backfill(t.Context(), server.URL+"/feed.atom", 0, WithMaxPages(1))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Three (edited)", "Four"}, itemTitles(items))
+	})
+	t.Run("paged rss", func(t *testing.T) {
+		items, err := Backfill(t.Context(), server.URL+"/feed.rss", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Older", "Newer"}, itemTitles(items))
+	})
+	t.Run("missing archive", func(t *testing.T) {
+		_, err := Backfill(t.Context(), server.URL+"/missing.atom", 10)
+		require.ErrorIs(t, err, ErrFetch)
+	})
+}
+
+func TestFeedGetPagination(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want types.Pagination
+	}{
+		{
+			name: "atom",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Paged</title>` +
+				`<link rel="self" type="application/atom+xml" href="https://example.com/feed.atom?page=2"/>` +
+				`<link rel="first" href="feed.atom"/><link rel="previous" href="feed.atom?page=1"/>` +
+				`<link rel="next" href="feed.atom?page=3"/>` +
+				`<link rel="last" href="https://example.com/feed.atom?page=9"/>` +
+				`</feed>`,
+			want: types.Pagination{
+				First:    "https://example.com/feed.atom",
+				Last:     "https://example.com/feed.atom?page=9",
+				Next:     "https://example.com/feed.atom?page=3",
+				Previous: "https://example.com/feed.atom?page=1",
+			},
+		},
+		{
+			name: "rss prev",
+			data: `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Paged</title>` +
+				`<atom:link rel="self" href="https://example.com/feed.rss?paged=2"/>` +
+				`<atom:link rel="prev" href="https://example.com/feed.rss"/></channel></rss>`,
+			want: types.Pagination{Previous: "https://example.com/feed.rss"},
+		},
+		{
+			name: "jsonfeed",
+			data: `{"version":"https://jsonfeed.org/version/1.1","title":"Paged",` +
+				`"feed_url":"https://example.com/feed.json",` +
+				`"next_url":"https://example.com/feed.json?page=2","items":[]}`,
+			want: types.Pagination{Next: "https://example.com/feed.json?page=2"},
+		},
+		{
+			name: "not paginated",
+			data: `<rss version="2.0"><channel><title>Single</title></channel></rss>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, feed.GetPagination())
+		})
+	}
+}
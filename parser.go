@@ -12,10 +12,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/immanent-tech/go-syndication/atom"
 	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
 	"github.com/immanent-tech/go-syndication/rss"
 	"github.com/immanent-tech/go-syndication/types"
 	"golang.org/x/net/html/charset"
@@ -26,20 +29,63 @@ var (
 	ErrParseBytes = errors.New("unable to parse bytes as feed")
 )
 
-// NewDecoder will create a new Feed of the given type from the given io.Reader.
-func NewDecoder[T any](data io.Reader) (*Feed, error) {
+// UnknownSourceError indicates the type of a feed source is not one of the formats supported by this package, or one
+// registered with RegisterFormat, so its SourceType cannot be determined.
+type UnknownSourceError struct {
+	// Type is the Go type of the source.
+	Type string
+}
+
+// Error satisfies the Error interface.
+func (e *UnknownSourceError) Error() string {
+	return "unknown feed source type " + e.Type
+}
+
+// NewDecoder will create a new Feed of the given type from the given io.Reader. Options can be passed to configure the
+// Feed. No validation is performed unless the WithValidation option is used.
+func NewDecoder[T any](data io.Reader, options ...Option) (*Feed, error) {
+	return newDecoder[T](data, newConfig(options...))
+}
+
+// newDecoder will create a new Feed of the given type from the given io.Reader, using the given config.
+func newDecoder[T any](data io.Reader, cfg *config) (*Feed, error) {
 	var (
-		original T
-		feed     *Feed
-		err      error
+		original  T
+		feed      *Feed
+		raw       *rawSource
+		repairs   []Repair
+		err       error
+		decodeCfg = cfg
 	)
-	if _, ok := any(original).(*jsonfeed.Feed); ok {
+	_, isJSON := any(original).(*jsonfeed.Feed)
+	start := time.Now()
+	counter := &countingReader{reader: data}
+	if data, err = decodeBOM(counter); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	if cfg.repair && !isJSON {
+		if data, decodeCfg, repairs, err = repairSource(data, cfg); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+		}
+	}
+	if decodeCfg.rawSource {
+		// Read the whole document up front so that it can be retained, then decode from it.
+		if raw, err = readRawSource(data, isJSON, decodeCfg); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+		}
+		data = bytes.NewReader(raw.document)
+	}
+	if isJSON {
 		// If the original is JSONFeed, unmarshal as JSON.
-		rd := json.NewDecoder(data)
+		rd := json.NewDecoder(decodeCfg.limitReader(data))
 		err = rd.Decode(&original)
+		// JSON is decoded in full, so apply any item limit afterwards.
+		if jsonFeed, ok := any(original).(*jsonfeed.Feed); ok && jsonFeed != nil && cfg.maxItems > 0 {
+			jsonFeed.Items = jsonFeed.Items[:min(cfg.maxItems, len(jsonFeed.Items))]
+		}
 	} else {
 		// Otherwise, unmarshal as XML.
-		original, err = Decode[T]("", data)
+		original, err = decode[T]("", data, decodeCfg)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
@@ -48,53 +94,237 @@ func NewDecoder[T any](data io.Reader) (*Feed, error) {
 	if !ok {
 		return nil, fmt.Errorf("%w: data is not a valid feed type %T", ErrParseBytes, original)
 	}
+	sourceType, err := parseSource(original)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
 	feed = &Feed{
 		FeedSource: source,
+		SourceType: sourceType,
+		config:     cfg,
+		validation: &validationResult{},
+		raw:        raw,
+		repairs:    repairs,
+	}
+	if err := feed.finishDecode(start, counter.count); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
 	}
-	feed.SourceType = parseSource(original)
 
 	return feed, nil
 }
 
 // NewFeedFromSource will create a new Feed from the given source that satisfies the FeedSource interface. This can be
-// used to create a Feed from an existing rss.RSS or atom.Feed object.
-func NewFeedFromSource[T types.FeedSource](source T) *Feed {
+// used to create a Feed from an existing rss.RSS or atom.Feed object. Options can be passed to configure the Feed. If
+// the source is not of a known format, the SourceType of the Feed is types.SourceTypeUnknown, and it cannot be
+// marshaled to JSON.
+func NewFeedFromSource[T types.FeedSource](source T, options ...Option) *Feed {
 	feed := &Feed{
 		FeedSource: source,
+		config:     newConfig(options...),
+		validation: &validationResult{},
 	}
-	feed.SourceType = parseSource(source)
+	sourceType, err := parseSource(source)
+	if err != nil {
+		sourceType = types.SourceTypeUnknown
+	}
+	feed.SourceType = sourceType
 	return feed
 }
 
-// parseSource will attempt to determine the appropriate SourceType value from the given interface object.
-func parseSource[T any](source T) types.SourceType {
+// parseSource will attempt to determine the appropriate SourceType value from the given interface object. If the
+// object is not the source of a known format, an UnknownSourceError is returned.
+func parseSource[T any](source T) (types.SourceType, error) {
 	switch any(source).(type) {
 	case *atom.Feed:
-		return types.SourceTypeAtom
+		return types.SourceTypeAtom, nil
 	case *rss.RSS:
-		return types.SourceTypeRSS
+		return types.SourceTypeRSS, nil
+	case *rdf.RDF:
+		return types.SourceTypeRDF, nil
+	case *jsonfeed.Feed:
+		return types.SourceTypeJSONFeed, nil
 	default:
-		return ""
+		if name, found := formatOf(source); found {
+			return name, nil
+		}
+		return types.SourceTypeUnknown, &UnknownSourceError{Type: fmt.Sprintf("%T", source)}
 	}
 }
 
+// sniffSize is the number of bytes inspected to determine the source type of some data.
+const sniffSize = 4096
+
+// NewFeedFromReader will create a new Feed from the given io.Reader, detecting the format of the feed from its content.
+// The data is decoded as it is read, so the whole feed is never buffered in memory. A document that starts with a byte
+// order mark is decoded as UTF-8 or UTF-16, as the mark indicates, whatever encoding it declares. Options can be passed
+// to configure the Feed.
+func NewFeedFromReader(r io.Reader, options ...Option) (*Feed, error) {
+	return newFeedFromReader(r, newConfig(options...))
+}
+
+// newFeedFromReader will create a new Feed from the given io.Reader, using the given config.
+func newFeedFromReader(r io.Reader, cfg *config) (*Feed, error) {
+	start := time.Now()
+	counter := &countingReader{reader: r}
+	r, err := decodeBOM(counter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	var repairs []Repair
+	decodeCfg := cfg
+	if cfg.repair {
+		// Repair the document before detecting its type, which can fail on the same breakage.
+		if r, decodeCfg, repairs, err = repairSource(r, cfg); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+		}
+	}
+	sourceType, data, err := detectSourceType(bufio.NewReaderSize(r, sniffSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+
+	var feed *Feed
+	switch sourceType {
+	case types.SourceTypeRSS:
+		feed, err = newDecoder[*rss.RSS](data, decodeCfg)
+	case types.SourceTypeAtom:
+		feed, err = newDecoder[*atom.Feed](data, decodeCfg)
+	case types.SourceTypeRDF:
+		feed, err = newDecoder[*rdf.RDF](data, decodeCfg)
+	case types.SourceTypeJSONFeed:
+		feed, err = newDecoder[*jsonfeed.Feed](data, decodeCfg)
+	default:
+		registered, found := lookupFormat(sourceType)
+		if !found {
+			return nil, fmt.Errorf("%w: unsupported source type %s", ErrParseBytes, sourceType)
+		}
+		feed, err = decodeFormat(registered, data, decodeCfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	feed.SourceType = sourceType
+	feed.config = cfg
+	feed.repairs = repairs
+	// Include detecting the format and repairing the document in the decoding time.
+	feed.stats.Bytes = counter.count
+	feed.stats.Decode = time.Since(start) - feed.stats.Validation
+
+	return feed, nil
+}
+
+// NewFeedFromBytes will create a new Feed from the given byte array, detecting the format of the feed from its content.
+// It is a convenience wrapper around NewFeedFromReader for data that is already in memory.
+func NewFeedFromBytes(data []byte, options ...Option) (*Feed, error) {
+	return NewFeedFromReader(bytes.NewReader(data), options...)
+}
+
+// NewItemFromBytes will create a new Item from the given standalone RSS <item> element, such as one delivered by a
+// webhook, without it having to be wrapped in a channel. Undeclared namespace prefixes are resolved as described by
+// rss.DecodeItem. Options can be passed to configure the Item.
+func NewItemFromBytes(data []byte, options ...Option) (*Item, error) {
+	source, err := rss.DecodeItem(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	item := newItem(source, types.SourceTypeRSS, "", newConfig(options...))
+	return &item, nil
+}
+
 // DetectSourceType determines the feed source by extracting key signatures from the data. It can detect supported feed
-// formats as well as HTML.
+// formats, any formats registered with RegisterFormat, as well as HTML. A JSON object is only detected as a JSONFeed if
+// its "version" member is a jsonfeed.org version URL.
 func DetectSourceType(r io.Reader) (types.SourceType, error) {
-	data := bufio.NewReader(r)
+	r, err := decodeBOM(r)
+	if err != nil {
+		return types.SourceTypeUnknown, err
+	}
+	sourceType, _, err := detectSourceType(bufio.NewReaderSize(r, sniffSize))
+	return sourceType, err
+}
 
-	// Peek enough bytes for content sniffing without consuming the reader.
-	peek, err := data.Peek(512)
+// DetectFormat determines the format of the feed in the given data from its content alone, for data such as files and
+// uploads where no Content-Type header is available. XML feeds are recognized by their root element (<rss>, <rdf:RDF>
+// or <feed>) and JSONFeed by the "version" member of the top-level object being a jsonfeed.org version URL. HTML pages
+// are reported as types.SourceTypeHTML. Formats registered with RegisterFormat are detected first. If the format cannot
+// be determined, types.SourceTypeUnknown is returned. UTF-16 documents are detected if they start with a byte order
+// mark. The detection is the same as that of DetectSourceType and NewFeedFromReader.
+func DetectFormat(data []byte) types.SourceType {
+	sourceType, err := DetectSourceType(bytes.NewReader(data))
 	if err != nil {
-		return types.SourceTypeUnknown, fmt.Errorf("peek at source file: %w", err)
+		return types.SourceTypeUnknown
 	}
+	return sourceType
+}
+
+// jsonFeedVersion matches the version URLs of JSONFeed (e.g., https://jsonfeed.org/version/1.1).
+var jsonFeedVersion = regexp.MustCompile(`^https?://jsonfeed\.org/version/`)
 
+// detectSourceType determines the feed source from the start of the data in the given buffered reader. It returns a
+// reader of the whole data to decode the feed from afterwards. The root element of an XML document usually lies within
+// the peeked start of the data, but it may follow a long prolog or comment, so the data is read until the root element
+// is found and what was read is replayed by the returned reader.
+func detectSourceType(data *bufio.Reader) (types.SourceType, io.Reader, error) {
+	// Peek enough bytes for content sniffing without consuming the reader. Data shorter than the peek size is fine.
+	peek, err := data.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return types.SourceTypeUnknown, data, fmt.Errorf("peek at source file: %w", err)
+	}
+	if len(peek) == 0 {
+		return types.SourceTypeUnknown, data, fmt.Errorf("%w: no data", ErrParseBytes)
+	}
+
+	// Registered formats may be based on HTML, XML or JSON, so they are detected before the built-in formats.
+	if name, found := sniffFormat(peek); found {
+		return name, data, nil
+	}
 	if looksLikeHTML(peek) {
-		return types.SourceTypeHTML, nil
+		return types.SourceTypeHTML, data, nil
+	}
+
+	// The rest of the detection may read past the peeked data, so what is read is replayed afterwards.
+	var read bytes.Buffer
+	replay := io.MultiReader(&read, data)
+	if looksLikeJSON(peek) {
+		if !isJSONFeed(io.TeeReader(data, &read)) {
+			return types.SourceTypeUnknown, replay, errors.New("unrecognized JSON document: no jsonfeed.org version")
+		}
+		return types.SourceTypeJSONFeed, replay, nil
 	}
 
 	// Fall back to XML-based root element detection for feeds (and XHTML).
-	return detectFeedSourceType(data)
+	sourceType, err := detectFeedSourceType(io.TeeReader(data, &read))
+	return sourceType, replay, err
+}
+
+// isJSONFeed reports whether the JSON object in the given reader is a JSONFeed, by its "version" member being a
+// jsonfeed.org version URL. Only the members of the object up to the version are read.
+func isJSONFeed(r io.Reader) bool {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return false
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return false
+		}
+		if key == "version" {
+			var version string
+			return dec.Decode(&version) == nil && jsonFeedVersion.MatchString(version)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// looksLikeJSON reports whether the data appears to be a JSON object.
+func looksLikeJSON(peek []byte) bool {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(peek, []byte("\xef\xbb\xbf")))
+	return bytes.HasPrefix(trimmed, []byte("{"))
 }
 
 func looksLikeHTML(peek []byte) bool {
@@ -5,12 +5,14 @@ package feeds
 
 import (
 	"bytes"
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/types"
 	"github.com/immanent-tech/go-syndication/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -130,14 +132,24 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid name", entries[0].GetAuthors()[0])
 		},
 	},
-	// // TODO: how to test name is NOT html encoded?
-	// "entry_author_name_contains_html.xml": {
-	// 	wantInvalid: true,
-	// },
-	// // TODO: how to test name is NOT html encoded?
-	// "entry_author_name_contains_html_cdata.xml": {
-	// 	wantInvalid: true,
-	// },
+	"entry_author_name_contains_html.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
+	},
+	"entry_author_name_contains_html_cdata.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
+	},
 	"entry_author_name_missing.xml": {
 		wantInvalid: true,
 		tests: func(t *testing.T, feed *atom.Feed) {
@@ -149,9 +161,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Contains(t, failedValidations["PersonConstruct.Name"], "required")
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"entry_author_name_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"name"}, feed.Entries[0].Authors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"entry_author_unknown_element.xml": {
 		wantInvalid: true,
@@ -190,9 +208,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "http://example.com/", *feed.Entries[0].Authors[0].URI)
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"entry_author_url_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"uri"}, feed.Authors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"entry_content_is_html.xml": {
 		wantInvalid: false,
@@ -312,13 +336,23 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid name", entries[0].GetContributors()[0])
 		},
 	},
-	// TODO: how to test name is NOT html encoded?
 	"entry_contributor_name_contains_html.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
 	},
-	// TODO: how to test name is NOT html encoded?
 	"entry_contributor_name_contains_html_cdata.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
 	},
 	"entry_contributor_name_missing.xml": {
 		wantInvalid: true,
@@ -331,9 +365,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Contains(t, failedValidations["PersonConstruct.Name"], "required")
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"entry_contributor_name_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"name"}, feed.Entries[0].Contributors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0].Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"entry_contributor_unknown_element.xml": {
 		wantInvalid: true,
@@ -369,9 +409,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "http://example.com/", *feed.Entries[0].Contributors[0].URI)
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"entry_contributor_url_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"uri"}, feed.Contributors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"entry_id_blank.xml": {
 		wantInvalid: true,
@@ -836,12 +882,23 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid summary", feed.Entries[0].GetDescription())
 		},
 	},
-	// TODO: this should fail but doesn't
 	"entry_summary_contains_html_cdata.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Entry.Summary"], "validateFn")
+		},
 	},
 	"entry_summary_contains_html.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Entry.Summary"], "validateFn")
+		},
 	},
 	"entry_summary_is_html.xml": {
 		tests: func(t *testing.T, feed *atom.Feed) {
@@ -1013,12 +1070,23 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid title", feed.Entries[0].GetTitle())
 		},
 	},
-	// TODO: this should fail but doesn't
 	"entry_title_contains_html_cdata.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Entry.Title"], "validateFn")
+		},
 	},
 	"entry_title_contains_html.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Entries[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Entry.Title"], "validateFn")
+		},
 	},
 	"entry_title_is_html.xml": {
 		tests: func(t *testing.T, feed *atom.Feed) {
@@ -1216,17 +1284,33 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid name", feed.GetAuthors()[0])
 		},
 	},
-	// // TODO: how to test name is NOT html encoded?
-	// "feed_author_name_contains_html.xml": {
-	// 	wantInvalid: true,
-	// },
-	// // TODO: how to test name is NOT html encoded?
-	// "feed_author_name_contains_html_cdata.xml": {
-	// 	wantInvalid: true,
-	// },
-	// TODO: might require custom unmarshal logic?
+	"feed_author_name_contains_html.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
+	},
+	"feed_author_name_contains_html_cdata.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
+	},
 	"feed_author_name_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"name"}, feed.Authors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Authors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"feed_author_unknown_element.xml": {
 		wantInvalid: true,
@@ -1320,13 +1404,41 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "Valid name", feed.GetContributors()[0])
 		},
 	},
-	// TODO: how to test name is NOT html encoded?
 	"feed_contributor_name_contains_html.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
 	},
-	// TODO: how to test name is NOT html encoded?
 	"feed_contributor_name_contains_html_cdata.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.Name"], "nohtml")
+		},
+	},
+	"feed_title_contains_html.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Feed.Title"], "validateFn")
+		},
+	},
+	"feed_title_contains_html_cdata.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["Feed.Title"], "validateFn")
+		},
 	},
 	"feed_contributor_name_missing.xml": {
 		wantInvalid: true,
@@ -1338,9 +1450,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Contains(t, failedValidations["PersonConstruct.Name"], "required")
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"feed_contributor_name_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"name"}, feed.Contributors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"feed_contributor_unknown_element.xml": {
 		wantInvalid: true,
@@ -1370,9 +1488,15 @@ var atomMustTests = map[string]atomTestSuite{
 			assert.Equal(t, "http://example.com/", *feed.Contributors[0].URI)
 		},
 	},
-	// TODO: might require custom unmarshal logic?
 	"feed_contributor_url_multiple.xml": {
 		wantInvalid: true,
+		tests: func(t *testing.T, feed *atom.Feed) {
+			t.Helper()
+			assert.Equal(t, []string{"uri"}, feed.Contributors[0].RepeatedElements)
+			failedValidations, err := getFailedValidations(validation.ValidateStruct(feed.Contributors[0]))
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["PersonConstruct.RepeatedElements"], "max")
+		},
 	},
 	"feed_copyright_is_inline_2.xml": {
 		wantDecodeErr: true,
@@ -1516,3 +1640,152 @@ func TestNewFeedFromBytesAtom(t *testing.T) {
 		})
 	}
 }
+
+func TestAtomEntrySource(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom"><title>Aggregator</title><id>urn:example:aggregator</id>` +
+		`<updated>2026-01-02T00:00:00Z</updated><author><name>Planet</name></author>` +
+		`<entry><title>Copied</title><id>urn:example:copied</id><updated>2026-01-01T00:00:00Z</updated>` +
+		`<author><name>Origin Author</name></author>` +
+		`<source><id>urn:example:origin</id><title>Origin Feed</title><updated>2026-01-01T00:00:00Z</updated>` +
+		`<link rel="self" href="https://origin.example.org/feed.atom"/></source></entry>` +
+		`<entry><title>Original</title><id>urn:example:original</id><updated>2026-01-01T00:00:00Z</updated>` +
+		`<author><name>Planet</name></author></entry>` +
+		`</feed>`)
+	feed, err := NewFeedFromBytes(data)
+	require.NoError(t, err)
+	require.NoError(t, feed.Validate())
+
+	source, ok := feed.FeedSource.(*atom.Feed)
+	require.True(t, ok)
+	require.Len(t, source.Entries, 2)
+	origin := source.Entries[0].GetOriginFeed()
+	require.NotNil(t, origin)
+	assert.Equal(t, "urn:example:origin", origin.ID.Value)
+	assert.Equal(t, "Origin Feed", origin.Title.String())
+	require.Len(t, origin.Links, 1)
+	assert.Equal(t, "https://origin.example.org/feed.atom", origin.Links[0].Href)
+	assert.Nil(t, source.Entries[1].GetOriginFeed())
+
+	// The source element survives re-encoding.
+	encoded, err := Encode(source)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), "<source>")
+	decoded, err := NewFeedFromBytes(encoded)
+	require.NoError(t, err)
+	decodedSource, ok := decoded.FeedSource.(*atom.Feed)
+	require.True(t, ok)
+	require.NotNil(t, decodedSource.Entries[0].GetOriginFeed())
+	assert.Equal(t, "Origin Feed", decodedSource.Entries[0].GetOriginFeed().Title.String())
+}
+
+func TestAtomFeedImage(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">` +
+		`<title>Images</title><id>urn:example:images</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<author><name>Planet</name></author>` +
+		`<icon>https://example.org/icon.png</icon>` +
+		`<media:thumbnail url="https://example.org/thumbnail.png"/>` +
+		`</feed>`)
+	feed, err := NewFeedFromBytes(data)
+	require.NoError(t, err)
+	require.NotNil(t, feed.GetImage())
+	assert.Equal(t, "https://example.org/icon.png", feed.GetImage().URL)
+
+	feed.SetImage(&types.ImageInfo{URL: "https://example.org/logo.png"})
+	assert.Equal(t, "https://example.org/logo.png", feed.GetImage().URL)
+	encoded, err := Encode(feed.FeedSource)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), "<logo>https://example.org/logo.png</logo>")
+	assert.Contains(t, string(encoded), "<icon>https://example.org/icon.png</icon>")
+}
+
+func TestAtomContributors(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<title>Contributors</title><id>urn:example:contributors</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<author><name>Author</name></author>` +
+		`<contributor><name>Alice</name><email>alice@example.org</email><uri>https://example.org/alice</uri>` +
+		`</contributor>` +
+		`<dc:creator>Creator</dc:creator><dc:contributor>Bob</dc:contributor>` +
+		`<entry><title>Entry</title><id>urn:example:entry</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<contributor><name>Carol</name></contributor>` +
+		`<dc:creator>Entry Creator</dc:creator><dc:contributor>Dave</dc:contributor></entry>` +
+		`</feed>`)
+	feed, err := Decode[*atom.Feed]("", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Alice (alice@example.org) https://example.org/alice", "Bob"}, feed.GetContributors())
+	assert.Equal(t, []atom.PersonConstruct{
+		{Name: "Alice", Email: new("alice@example.org"), URI: new("https://example.org/alice")},
+		{Name: "Bob"},
+	}, feed.GetContributorPersons())
+
+	require.Len(t, feed.Entries, 1)
+	entry := &feed.Entries[0]
+	assert.Equal(t, []string{"Carol", "Dave"}, entry.GetContributors())
+	assert.Equal(t, []atom.PersonConstruct{{Name: "Carol"}, {Name: "Dave"}}, entry.GetContributorPersons())
+}
+
+func TestAtomCategoriesByScheme(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom">` +
+		`<title>Categories</title><id>urn:example:categories</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<category term="go" scheme="https://example.org/tags"/><category term="misc"/>` +
+		`<entry><title>Entry</title><id>urn:example:entry</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<category term="news" scheme="https://example.org/sections" label="News"/>` +
+		`<category term="feeds" scheme="https://example.org/tags"/>` +
+		`<category term="syndication" scheme="https://example.org/tags"/></entry>` +
+		`</feed>`)
+	feed, err := Decode[*atom.Feed]("", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	terms := func(categories []atom.Category) []string {
+		var values []string
+		for _, category := range categories {
+			values = append(values, category.Term.Value)
+		}
+		return values
+	}
+	assert.Equal(t, []string{"go"}, terms(feed.GetCategoriesByScheme("https://example.org/tags")))
+	assert.Equal(t, []string{"misc"}, terms(feed.GetCategoriesByScheme("")))
+	require.Len(t, feed.Entries, 1)
+	entry := &feed.Entries[0]
+	assert.Equal(t, []string{"feeds", "syndication"}, terms(entry.GetCategoriesByScheme("https://example.org/tags")))
+	sections := entry.GetCategoriesByScheme("https://example.org/sections")
+	require.Len(t, sections, 1)
+	assert.Equal(t, "https://example.org/sections", sections[0].GetScheme())
+	assert.Equal(t, "News", sections[0].Label.Value)
+	assert.Empty(t, entry.GetCategoriesByScheme("https://example.org/unknown"))
+}
+
+func TestAtomTextConstructs(t *testing.T) {
+	data := []byte(`<feed xmlns="http://www.w3.org/2005/Atom" xmlns:h="http://www.w3.org/1999/xhtml">` +
+		`<title type="html">&lt;em&gt;Text&lt;/em&gt; &amp;amp; constructs</title>` +
+		`<subtitle type="xhtml"><h:div>A <h:b class="x">bold</h:b> &amp; broken<h:br/>subtitle</h:div></subtitle>` +
+		`<rights type="xhtml"><div xmlns="http://www.w3.org/1999/xhtml"><p>&#169; 2026</p></div></rights>` +
+		`<id>urn:example:text</id><updated>2026-01-02T00:00:00Z</updated>` +
+		`<entry><title type="text">Plain &amp; simple</title><id>urn:example:entry</id>` +
+		`<updated>2026-01-02T00:00:00Z</updated>` +
+		`<summary type="xhtml"><h:div><h:p>Summary</h:p></h:div></summary>` +
+		`<content type="xhtml"><h:div><h:p>Content</h:p></h:div></content></entry>` +
+		`</feed>`)
+	feed, err := Decode[*atom.Feed]("", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assertTexts := func(t *testing.T, feed *atom.Feed) {
+		t.Helper()
+		assert.Equal(t, "<em>Text</em> &amp; constructs", feed.GetTitle())
+		assert.Equal(t, `A <b class="x">bold</b> &amp; broken<br/>subtitle`, feed.GetDescription())
+		assert.Equal(t, "<p>© 2026</p>", *feed.GetRights())
+		require.Len(t, feed.Entries, 1)
+		entry := &feed.Entries[0]
+		assert.Equal(t, "Plain & simple", entry.GetTitle())
+		assert.Equal(t, "<p>Summary</p>", entry.GetDescription())
+		assert.Equal(t, "<p>Content</p>", *entry.GetContent())
+	}
+	assertTexts(t, feed)
+
+	// The constructs are encoded as valid XHTML, so they survive a round trip.
+	encoded, err := xml.Marshal(feed)
+	require.NoError(t, err)
+	feed, err = Decode[*atom.Feed]("", bytes.NewReader(encoded))
+	require.NoError(t, err)
+	assertTexts(t, feed)
+}
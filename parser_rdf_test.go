@@ -20,6 +20,8 @@ type rdfTestSuite struct {
 }
 
 var rdfMustPass = map[string]rdfTestSuite{
+	"rss10_textinput.xml":  {tests: assertRDFTextInput},
+	"rss10_textinput2.xml": {tests: assertRDFTextInput},
 	"rss10_spec_sample_noerror.xml": {
 		tests: func(t *testing.T, feed *rdf.RDF) {
 			// Channel.
@@ -47,6 +49,17 @@ var rdfMustPass = map[string]rdfTestSuite{
 	},
 }
 
+// assertRDFTextInput checks the textinput of the rss10_textinput fixtures.
+func assertRDFTextInput(t *testing.T, feed *rdf.RDF) {
+	t.Helper()
+
+	if assert.NotNil(t, feed.TextInput) {
+		assert.Equal(t, "Search me", feed.TextInput.Title)
+		assert.Equal(t, "query", feed.TextInput.Name)
+		assert.Equal(t, "http://www.example.com/search.php", feed.TextInput.Link)
+	}
+}
+
 var rdfTests = map[string]map[string]rdfTestSuite{
 	"test/assets/rss/must": rdfMustPass,
 }
@@ -5,6 +5,7 @@ package feeds
 
 import (
 	"bytes"
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"slices"
@@ -16,6 +17,7 @@ import (
 	"github.com/immanent-tech/go-syndication/extensions/media"
 	"github.com/immanent-tech/go-syndication/rss"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type rssTestSuite struct {
@@ -25,24 +27,26 @@ type rssTestSuite struct {
 }
 
 var rssMustPass = map[string]rssTestSuite{
-	// "admin_errorReportsTo.xml": {wantInvalid: false},
-	// "admin_generatorAgent.xml": false,
 	"atom_link2.xml": {
 		wantInvalid: false,
 		tests: func(t *testing.T, feed *rss.RSS) {
 			t.Helper()
-			assert.Equal(t, atom.LinkRelSelf, feed.Channel.AtomLink.Rel)
-			assert.Equal(t, "http://www.rss-world.info/", *feed.Channel.AtomLink.UndefinedContent)
-			assert.Equal(t, "http://feeds.feedburner.com/rssworld/news", feed.Channel.AtomLink.Href)
+			if assert.Len(t, feed.Channel.AtomLinks, 1) {
+				assert.Equal(t, atom.LinkRelSelf, feed.Channel.AtomLinks[0].Rel)
+				assert.Equal(t, "http://www.rss-world.info/", *feed.Channel.AtomLinks[0].UndefinedContent)
+				assert.Equal(t, "http://feeds.feedburner.com/rssworld/news", feed.Channel.AtomLinks[0].Href)
+			}
 		},
 	},
 	"atom_link.xml": {
 		wantInvalid: false,
 		tests: func(t *testing.T, feed *rss.RSS) {
 			t.Helper()
-			assert.Equal(t, atom.LinkRelSelf, feed.Channel.AtomLink.Rel)
-			assert.Equal(t, "http://www.rss-world.info/", *feed.Channel.AtomLink.UndefinedContent)
-			assert.Equal(t, "http://feeds.feedburner.com/rssworld/news", feed.Channel.AtomLink.Href)
+			if assert.Len(t, feed.Channel.AtomLinks, 1) {
+				assert.Equal(t, atom.LinkRelSelf, feed.Channel.AtomLinks[0].Rel)
+				assert.Equal(t, "http://www.rss-world.info/", *feed.Channel.AtomLinks[0].UndefinedContent)
+				assert.Equal(t, "http://feeds.feedburner.com/rssworld/news", feed.Channel.AtomLinks[0].Href)
+			}
 		},
 	},
 	// TODO: implement blogChannel
@@ -50,7 +54,6 @@ var rssMustPass = map[string]rssTestSuite{
 	// "blogChannel_blogRoll.xml":          false,
 	// "blogChannel_changes.xml":           false,
 	// "blogChannel_mySubscriptions.xml":   false,
-	// "cp_server.xml":                     false,
 	"dcdate_complete_date.xml": {
 		wantInvalid: false,
 		tests: func(t *testing.T, feed *rss.RSS) {
@@ -175,8 +178,6 @@ var rssMustPass = map[string]rssTestSuite{
 	// "invalid_blogChannel_blogRoll.xml":        true,
 	// "invalid_blogChannel_mySubscriptions.xml": true,
 	// "invalid_dcdate.xml":           true,
-	// "invalid_dclanguage_blank.xml": true,
-	// "invalid_dclanguage.xml":       true,
 	// TODO: implement geo
 	// "invalid_geo_geo_latitude.xml":            true,
 	// "invalid_geo_geo_longitude.xml":           true,
@@ -197,8 +198,6 @@ var rssMustPass = map[string]rssTestSuite{
 	"invalid_sy_updateFrequency_decimal.xml":  {wantDecodeErr: true},
 	"invalid_sy_updateFrequency_negative.xml": {wantInvalid: true},
 	"invalid_sy_updateFrequency_zero.xml":     {wantInvalid: true},
-	// "invalid_sy_updatePeriod_blank.xml":       {wantInvalid: true},
-	// "invalid_sy_updatePeriod.xml": {wantInvalid: true},
 	// "invalid_xml.xml": {wantDecodeErr: true},
 	// "l_permalink.xml":
 	"missing_namespace2.xml":          {wantInvalid: true},
@@ -245,8 +244,6 @@ var rssMustPass = map[string]rssTestSuite{
 	// rss10_resources.xml*
 	// rss10_spec_sample_noerror.xml*
 	// rss10_spec_sample_nowarn.xml*
-	// rss10_textinput2.xml
-	// rss10_textinput.xml
 	// rss10_title.xml
 	// rss10_trackback_invalid_about.xml
 	// rss10_trackback_invalid_ping.xml
@@ -328,12 +325,111 @@ var rssMustPass = map[string]rssTestSuite{
 	// valid_rss_090.xml
 	// valid_slash_all.xml
 	// valid_taxo_all.xml
-	// xml_utf-8_bom_with_ascii_declaration.xml
+	"xml_utf-8_bom_with_ascii_declaration.xml": {
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+			// The byte order mark takes precedence over the US-ASCII declaration.
+			assert.NotNil(t, feed)
+		},
+	},
 	// xmlversion_10.xml
 	// xmlversion_11.xml
 }
 
 var rss20 = map[string]rssTestSuite{
+	"element-channel-item-guid/guid_value_isPermalink_true.xml": {
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+
+			assert.True(t, feed.Channel.Items[0].GUID.IsPermaLink)
+			assert.Equal(t, "http://example.com", feed.Channel.Items[0].GetLink())
+		},
+	},
+	"element-channel-item-guid/valid_permalink_url.xml": {
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+
+			assert.True(t, feed.Channel.Items[0].GUID.IsPermaLink)
+			assert.Equal(t, "http://www.example.com/id/1", feed.Channel.Items[0].GetLink())
+		},
+	},
+	"element-channel-item-guid/guid_value_isPermalink_false.xml": {
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+
+			assert.False(t, feed.Channel.Items[0].GUID.IsPermaLink)
+			assert.Empty(t, feed.Channel.Items[0].GetLink())
+		},
+	},
+	"element-channel-cloud/cloud_protocol.xml": {
+		wantInvalid: false,
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+
+			if assert.NotNil(t, feed.GetCloud()) {
+				assert.Equal(t, "radio.xmlstoragesystem.com", feed.GetCloud().Domain)
+				assert.Equal(t, 80, feed.GetCloud().Port)
+				assert.Equal(t, "/RPC2", feed.GetCloud().Path)
+				assert.Equal(t, "pingMe", feed.GetCloud().RegisterProcedure)
+				assert.Equal(t, rss.Soap, feed.GetCloud().Protocol)
+			}
+			assert.NoError(t, feed.Validate())
+		},
+	},
+	"element-channel-cloud/cloud_domain.xml":                       {wantInvalid: false},
+	"element-channel-cloud/cloud_path.xml":                         {wantInvalid: false},
+	"element-channel-cloud/cloud_port.xml":                         {wantInvalid: false},
+	"element-channel-cloud/cloud_port_integer.xml":                 {wantInvalid: false},
+	"element-channel-cloud/cloud_registerprocedure.xml":            {wantInvalid: false},
+	"element-channel-cloud/invalid_cloud_decimal_port.xml":         {wantDecodeErr: true},
+	"element-channel-cloud/invalid_cloud_negative_port.xml":        {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_no_domain.xml":            {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_no_path.xml":              {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_no_port.xml":              {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_no_protocol.xml":          {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_no_registerprocedure.xml": {wantInvalid: true},
+	"element-channel-cloud/invalid_cloud_nonnumeric_port.xml":      {wantDecodeErr: true},
+	"element-channel-cloud/invalid_cloud_zero_port.xml":            {wantInvalid: true},
+	"element-channel-textinput/rss20_camel_textInput.xml": {
+		wantInvalid: false,
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+
+			if assert.NotNil(t, feed.GetTextInput()) {
+				assert.NotEmpty(t, feed.GetTextInput().Title)
+				assert.NotEmpty(t, feed.GetTextInput().Name)
+			}
+			assert.NoError(t, feed.Validate())
+		},
+	},
+	"element-channel-textinput/invalid_textInput_no_description.xml":    {wantInvalid: true},
+	"element-channel-textinput/invalid_textInput_no_link.xml":           {wantInvalid: true},
+	"element-channel-textinput/invalid_textInput_no_name.xml":           {wantInvalid: true},
+	"element-channel-textinput/invalid_textInput_no_title.xml":          {wantInvalid: true},
+	"element-channel-textinput-link/invalid_textInput_invalid_link.xml": {wantInvalid: true},
+	"element-channel-textinput-link/textInput_valid_link.xml":           {wantInvalid: false},
+	"element-channel-textinput-description/textInput_description.xml":   {wantInvalid: false},
+	"element-channel-textinput-name/textInput_name.xml":                 {wantInvalid: false},
+	"element-channel-textinput-title/textInput_title.xml":               {wantInvalid: false},
+	"element-channel-textinput-title/invalid_textInput_title_contains_html.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(feed.Validate())
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["RSS.Channel.TextInput.Title"], "nohtml")
+		},
+	},
+	"element-channel-image-title/image_title_contains_html.xml": {
+		wantInvalid: true,
+		tests: func(t *testing.T, feed *rss.RSS) {
+			t.Helper()
+			failedValidations, err := getFailedValidations(feed.Validate())
+			require.NoError(t, err)
+			assert.Contains(t, failedValidations["RSS.Channel.Image.Title"], "nohtml")
+		},
+	},
+	"element-channel-title/invalid_title_contains_html.xml": {wantInvalid: false},
 	"element-channel-image-description/image_no_description.xml": {
 		wantInvalid: false,
 		tests: func(t *testing.T, feed *rss.RSS) {
@@ -360,7 +456,6 @@ var rss20 = map[string]rssTestSuite{
 	"element-channel/missing_channel_title.xml":                     {wantInvalid: true},
 	"element-channel-item/invalid_item_no_title_or_description.xml": {wantInvalid: true},
 	"element-channel-link/invalid_link.xml":                         {wantInvalid: true},
-	// "element-channel-link/invalid_link2.xml":                        {wantInvalid: true},
 	"element-channel-link/link.xml": {
 		wantInvalid: false,
 		tests: func(t *testing.T, feed *rss.RSS) {
@@ -398,9 +493,11 @@ var rssMedia = map[string]rssTestSuite{
 			item := feed.Channel.Items[0]
 			assert.Equal(t, "Story about something", item.GetTitle())
 			assert.Equal(t, "http://www.foo.com/item1.htm", item.GetLink())
-			assert.Equal(t, "http://www.foo.com/file.mov", item.Enclosure.URL)
-			assert.Equal(t, "video/quicktime", item.Enclosure.Type)
-			assert.Equal(t, 320000, item.Enclosure.Length)
+			if assert.NotNil(t, item.GetEnclosure()) {
+				assert.Equal(t, "http://www.foo.com/file.mov", item.GetEnclosure().URL)
+				assert.Equal(t, "video/quicktime", item.GetEnclosure().Type)
+				assert.Equal(t, 320000, item.GetEnclosure().Length)
+			}
 		},
 	},
 	"example2.xml": {
@@ -540,3 +637,140 @@ func TestNewFeedFromBytesRSS(t *testing.T) {
 		})
 	}
 }
+
+func TestNewItemFromBytes(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantErr     bool
+		wantAuthors []string
+		wantContent string
+	}{
+		{
+			name: "undeclared prefixes",
+			data: `<item><title>Webhook Item</title><link>https://example.com/item</link>` +
+				`<dc:creator>Jane Doe</dc:creator><content:encoded><![CDATA[<p>Hello</p>]]></content:encoded></item>`,
+			wantAuthors: []string{"Jane Doe"},
+			wantContent: "<p>Hello</p>",
+		},
+		{
+			name: "declared prefixes",
+			data: `<item xmlns:creator="http://purl.org/dc/elements/1.1/"><title>Webhook Item</title>` +
+				`<link>https://example.com/item</link><creator:creator>Jane Doe</creator:creator></item>`,
+			wantAuthors: []string{"Jane Doe"},
+		},
+		{
+			name:    "not an item",
+			data:    `<entry><title>Webhook Item</title></entry>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := NewItemFromBytes([]byte(tt.data))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, rss.ErrNotItem)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Webhook Item", item.GetTitle())
+			assert.Equal(t, "https://example.com/item", item.GetLink())
+			assert.Equal(t, tt.wantAuthors, item.GetAuthors())
+			if tt.wantContent != "" {
+				assert.Contains(t, *item.GetContent(), tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestRSSItemGetLink(t *testing.T) {
+	tests := map[string]struct {
+		item string
+		want string
+	}{
+		"link": {
+			item: `<item><title>Item</title><link>https://example.com/link</link>` +
+				`<guid>https://example.com/guid</guid></item>`,
+			want: "https://example.com/link",
+		},
+		"feedburner origLink": {
+			item: `<item><title>Item</title><link>https://feedproxy.example.com/~r/item</link>` +
+				`<feedburner:origLink>https://example.com/original</feedburner:origLink></item>`,
+			want: "https://example.com/original",
+		},
+		"permalink guid": {
+			item: `<item><title>Item</title><guid isPermaLink="true">https://example.com/guid</guid></item>`,
+			want: "https://example.com/guid",
+		},
+		"non-URL permalink guid": {
+			item: `<item><title>Item</title><guid>tag:example.com,2026:1</guid></item>`,
+		},
+		"non-permalink guid": {
+			item: `<item><title>Item</title><guid isPermaLink="false">https://example.com/guid</guid></item>`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := `<rss version="2.0" xmlns:feedburner="http://rssnamespace.org/feedburner/ext/1.0"><channel>` +
+				`<title>Links</title>` + tt.item + `</channel></rss>`
+			feed, err := Decode[*rss.RSS]("", strings.NewReader(data))
+			if assert.NoError(t, err) && assert.Len(t, feed.Channel.Items, 1) {
+				assert.Equal(t, tt.want, feed.Channel.Items[0].GetLink())
+			}
+		})
+	}
+}
+
+func TestRSSChannelItunes(t *testing.T) {
+	data := `<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">` +
+		`<channel><title>Podcast</title>` +
+		`<itunes:owner><itunes:name>Owner</itunes:name><itunes:email>owner@example.com</itunes:email></itunes:owner>` +
+		`<itunes:block>Yes</itunes:block><itunes:complete>no</itunes:complete>` +
+		`<itunes:new-feed-url> https://example.com/new.xml </itunes:new-feed-url></channel></rss>`
+	feed, err := Decode[*rss.RSS]("", strings.NewReader(data))
+	require.NoError(t, err)
+	owner := feed.GetItunesOwner()
+	require.NotNil(t, owner)
+	assert.Equal(t, "Owner", owner.Name)
+	assert.Equal(t, "owner@example.com", owner.Email)
+	assert.True(t, feed.IsBlocked())
+	assert.False(t, feed.IsComplete())
+	assert.Equal(t, "https://example.com/new.xml", feed.GetNewFeedURL())
+
+	encoded, err := xml.Marshal(feed)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), ">Yes</block>")
+	decoded, err := Decode[*rss.RSS]("", bytes.NewReader(encoded))
+	require.NoError(t, err)
+	assert.True(t, decoded.IsBlocked())
+
+	data = `<rss version="2.0"><channel><title>Feed</title></channel></rss>`
+	feed, err = Decode[*rss.RSS]("", strings.NewReader(data))
+	require.NoError(t, err)
+	assert.Nil(t, feed.GetItunesOwner())
+	assert.False(t, feed.IsBlocked())
+	assert.Empty(t, feed.GetNewFeedURL())
+}
+
+func TestRSSChannelAtomLinks(t *testing.T) {
+	data := `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Feed</title>` +
+		`<atom:link rel="hub" href="https://hub.example.com/a"/>` +
+		`<atom:link rel="self" href="https://example.com/feed"/>` +
+		`<atom:link rel="HUB" href="https://hub.example.com/b"/><atom:link rel="next" href="https://example.com/2"/>` +
+		`</channel></rss>`
+	feed, err := Decode[*rss.RSS]("", strings.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/feed", feed.GetSourceURL())
+	hubs := feed.GetAtomLinks(atom.LinkRelHub)
+	require.Len(t, hubs, 2)
+	assert.Equal(t, "https://hub.example.com/b", hubs[1].Href)
+	next := feed.GetAtomLink(atom.LinkRelNext)
+	require.NotNil(t, next)
+	assert.Equal(t, "https://example.com/2", next.Href)
+	assert.Nil(t, feed.GetAtomLink(atom.LinkRelPrevious))
+	assert.Empty(t, feed.GetAtomLinks(atom.LinkRelPrevious))
+
+	next.Href = "https://example.com/page/2"
+	assert.Equal(t, "https://example.com/page/2", feed.Channel.AtomLinks[3].Href)
+}
@@ -4,10 +4,18 @@
 package feeds
 
 import (
+	"encoding/json"
 	"errors"
 	"slices"
+	"strings"
+	"testing"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
 	"github.com/immanent-tech/go-syndication/validation"
 )
 
@@ -26,3 +34,132 @@ func getFailedValidations(err error) (map[string][]string, error) {
 	}
 	return failedValidations, nil
 }
+
+func TestDetectSourceType(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			got, err := DetectSourceType(strings.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, format, got)
+		})
+	}
+	t.Run("html", func(t *testing.T) {
+		got, err := DetectSourceType(strings.NewReader("<!DOCTYPE html><html><body></body></html>"))
+		require.NoError(t, err)
+		assert.Equal(t, types.SourceTypeHTML, got)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			assert.Equal(t, format, DetectFormat([]byte(data)))
+		})
+	}
+	tests := map[string]struct {
+		data string
+		want types.SourceType
+	}{
+		"rdf":                {`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"/>`, types.SourceTypeRDF},
+		"html":               {"<!DOCTYPE html><html><body></body></html>", types.SourceTypeHTML},
+		"jsonfeed http":      {`{"title":"Example","version":"http://jsonfeed.org/version/1"}`, types.SourceTypeJSONFeed},
+		"json other version": {`{"version":"1.0"}`, types.SourceTypeUnknown},
+		"json not object":    {`{"version":`, types.SourceTypeUnknown},
+		"unknown xml":        {`<urlset/>`, types.SourceTypeUnknown},
+		"empty":              {"", types.SourceTypeUnknown},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectFormat([]byte(tt.data)))
+		})
+	}
+}
+
+// unknownSource is a FeedSource of a format that is neither supported nor registered.
+type unknownSource struct {
+	rss.RSS
+}
+
+func TestNewFeedFromSource(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			decoded := newTestFeed(t, format)
+			assert.Equal(t, format, decoded.SourceType)
+
+			feed := NewFeedFromSource(decoded.FeedSource)
+			assert.Equal(t, format, feed.SourceType)
+
+			data, err := json.Marshal(feed)
+			require.NoError(t, err)
+			var roundTripped Feed
+			require.NoError(t, json.Unmarshal(data, &roundTripped))
+			assert.Equal(t, format, roundTripped.SourceType)
+			assert.Equal(t, feed.GetTitle(), roundTripped.GetTitle())
+		})
+	}
+	t.Run("unknown", func(t *testing.T) {
+		feed := NewFeedFromSource(&unknownSource{RSS: *rss.NewRSS("Example", "Example", "https://example.com/")})
+		assert.Equal(t, types.SourceTypeUnknown, feed.SourceType)
+
+		_, err := json.Marshal(feed)
+		require.ErrorIs(t, err, ErrMarshal)
+		sourceErr, ok := errors.AsType[*UnknownSourceError](err)
+		require.True(t, ok)
+		assert.Equal(t, "*feeds.unknownSource", sourceErr.Type)
+
+		_, err = NewDecoder[*unknownSource](strings.NewReader(streamTests[types.SourceTypeRSS]))
+		require.ErrorIs(t, err, ErrParseBytes)
+		_, ok = errors.AsType[*UnknownSourceError](err)
+		assert.True(t, ok)
+	})
+}
+
+func TestNewFeedFromReader(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed, err := NewFeedFromReader(strings.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, format, feed.SourceType)
+			assert.Equal(t, "Example Feed", feed.GetTitle())
+			assert.Len(t, feed.GetItems(), 3)
+		})
+	}
+	t.Run("options", func(t *testing.T) {
+		feed, err := NewFeedFromBytes([]byte(streamTests[types.SourceTypeRSS]), WithMaxItems(1))
+		require.NoError(t, err)
+		assert.Len(t, feed.GetItems(), 1)
+	})
+	t.Run("html", func(t *testing.T) {
+		_, err := NewFeedFromReader(strings.NewReader("<!DOCTYPE html><html><body></body></html>"))
+		require.ErrorIs(t, err, ErrParseBytes)
+	})
+	t.Run("empty", func(t *testing.T) {
+		_, err := NewFeedFromReader(strings.NewReader(""))
+		require.ErrorIs(t, err, ErrParseBytes)
+	})
+	t.Run("json not a jsonfeed", func(t *testing.T) {
+		_, err := NewFeedFromReader(strings.NewReader(`{"foo":1}`))
+		require.ErrorIs(t, err, ErrParseBytes)
+	})
+	t.Run("json late version", func(t *testing.T) {
+		// The version follows a member longer than the data peeked to detect the format.
+		data := `{"description":"` + strings.Repeat("x", 2*sniffSize) + `",` +
+			`"version":"https://jsonfeed.org/version/1.1","title":"Example Feed"}`
+		assert.Equal(t, types.SourceTypeJSONFeed, DetectFormat([]byte(data)))
+		feed, err := NewFeedFromReader(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, "Example Feed", feed.GetTitle())
+	})
+	t.Run("long prolog", func(t *testing.T) {
+		// The root element follows a comment longer than the data peeked to detect the format.
+		data := `<?xml version="1.0"?><!--` + strings.Repeat("x", 2*sniffSize) + `-->` +
+			strings.TrimPrefix(streamTests[types.SourceTypeRSS], `<?xml version="1.0"?>`)
+		got, err := DetectSourceType(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, types.SourceTypeRSS, got)
+		feed, err := NewFeedFromReader(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, "Example Feed", feed.GetTitle())
+		assert.Len(t, feed.GetItems(), 3)
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// permalinkCacheSize is the maximum number of resolved permalinks cached by Item.ResolvePermalink.
+const permalinkCacheSize = 1024
+
+// ErrPermalink indicates the permalink of an item could not be resolved.
+var ErrPermalink = errors.New("unable to resolve permalink")
+
+var (
+	// trackingParams are query parameters added to links by analytics, advertising and email services to track clicks.
+	trackingParams = []string{
+		"fbclid", "gclid", "dclid", "gbraid", "wbraid", "msclkid", "yclid", "igshid", "twclid",
+		"mc_cid", "mc_eid", "_hsenc", "_hsmi", "mkt_tok", "ref_src",
+	}
+	// trackingParamPrefixes are prefixes of query parameters added to links to track clicks, such as the Urchin (Google
+	// Analytics) "utm_*" parameters.
+	trackingParamPrefixes = []string{"utm_"}
+)
+
+// permalinks caches the permalinks resolved by Item.ResolvePermalink, by the link they were resolved from.
+var permalinks = &permalinkCache{entries: make(map[string]string)}
+
+// ResolvePermalink returns the canonical URL of the article the Item links to, which readers should store in place of
+// the link of the item. Any redirects from the link, such as those of URL shorteners or feed proxies (e.g., feedproxy),
+// are followed, and tracking parameters are removed from the URL they lead to, see StripTrackingParams. Results are
+// cached, so resolving the permalink of the same link again does not make another request.
+//
+// The given client is used to follow the redirects. If it is nil, a default client is used.
+func (i *Item) ResolvePermalink(ctx context.Context, client *resty.Client) (string, error) {
+	link := strings.TrimSpace(i.GetLink())
+	if !isHTTPURL(link) {
+		return "", fmt.Errorf("%w: item has no HTTP(S) link", ErrPermalink)
+	}
+	if permalink, found := permalinks.get(link); found {
+		return permalink, nil
+	}
+	if client == nil {
+		client = types.NewClient()
+	}
+
+	// Try a HEAD request first, to avoid downloading the article, falling back to GET for servers that don't allow it.
+	resp, err := client.R().SetContext(ctx).SetDoNotParseResponse(true).Head(link)
+	if err == nil && (resp.StatusCode() == http.StatusMethodNotAllowed || resp.StatusCode() == http.StatusNotImplemented) {
+		resp.RawBody().Close()
+		resp, err = client.R().SetContext(ctx).SetDoNotParseResponse(true).Get(link)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrPermalink, err)
+	}
+	defer resp.RawBody().Close()
+	if resp.IsError() {
+		return "", fmt.Errorf("%w: %s", ErrPermalink, resp.Status())
+	}
+
+	permalink := StripTrackingParams(resp.RawResponse.Request.URL.String())
+	permalinks.add(link, permalink)
+	return permalink, nil
+}
+
+// StripTrackingParams removes the query parameters used to track clicks, such as "utm_source" or "fbclid", from the
+// given URL. The order of the remaining parameters is preserved. Values that cannot be parsed as a URL are returned
+// unchanged.
+func StripTrackingParams(link string) string {
+	u, err := url.Parse(link)
+	if err != nil || u.RawQuery == "" {
+		return link
+	}
+	params := strings.Split(u.RawQuery, "&")
+	kept := slices.DeleteFunc(slices.Clone(params), func(param string) bool {
+		name, _, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		return isTrackingParam(name)
+	})
+	if len(kept) == len(params) {
+		return link
+	}
+	u.RawQuery = strings.Join(kept, "&")
+	return u.String()
+}
+
+// isTrackingParam reports whether the query parameter with the given name is used to track clicks.
+func isTrackingParam(name string) bool {
+	name = strings.ToLower(name)
+	if slices.Contains(trackingParams, name) {
+		return true
+	}
+	return slices.ContainsFunc(trackingParamPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// permalinkCache is a bounded cache of resolved permalinks. When full, the oldest entry is evicted.
+type permalinkCache struct {
+	entries map[string]string
+	order   []string
+	mu      sync.Mutex
+}
+
+// get returns the cached permalink for the given link, if any.
+func (c *permalinkCache) get(link string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	permalink, found := c.entries[link]
+	return permalink, found
+}
+
+// add caches the given permalink for the given link.
+func (c *permalinkCache) add(link, permalink string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, found := c.entries[link]; found {
+		return
+	}
+	if len(c.order) >= permalinkCacheSize {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.entries[link] = permalink
+	c.order = append(c.order, link)
+}
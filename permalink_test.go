@@ -0,0 +1,100 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func TestItemResolvePermalink(t *testing.T) {
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/short", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Redirect(w, r, "/proxy?utm_source=feed", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/proxy", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/article?id=1&utm_medium=rss&fbclid=abc&page=2", http.StatusFound)
+	})
+	mux.HandleFunc("/article", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/no-head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.Redirect(w, r, "/article?id=2", http.StatusFound)
+	})
+	mux.HandleFunc("/missing", http.NotFound)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name    string
+		link    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "redirects",
+			link: server.URL + "/short",
+			want: server.URL + "/article?id=1&page=2",
+		},
+		{
+			name: "head not allowed",
+			link: server.URL + "/no-head",
+			want: server.URL + "/article?id=2",
+		},
+		{
+			name:    "not found",
+			link:    server.URL + "/missing",
+			wantErr: true,
+		},
+		{
+			name:    "no link",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &Item{ItemSource: &rss.Item{Link: tt.link}}
+			got, err := item.ResolvePermalink(t.Context(), nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrPermalink)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// The resolved permalink is cached.
+	item := &Item{ItemSource: &rss.Item{Link: server.URL + "/short"}}
+	got, err := item.ResolvePermalink(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/article?id=1&page=2", got)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestStripTrackingParams(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a?utm_source=x&b=1&UTM_Campaign=y&a=2": "https://example.com/a?b=1&a=2",
+		"https://example.com/a?gclid=1#section":                     "https://example.com/a#section",
+		"https://example.com/a?ref=home":                            "https://example.com/a?ref=home",
+		"https://example.com/a":                                     "https://example.com/a",
+		"not a url %zz":                                             "not a url %zz",
+	}
+	for link, want := range tests {
+		assert.Equal(t, want, StripTrackingParams(link), link)
+	}
+}
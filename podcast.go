@@ -0,0 +1,103 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/extensions/podcast"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// ErrPodcastFetch indicates a chapters or transcript file of a podcast episode could not be fetched.
+var ErrPodcastFetch = errors.New("unable to fetch podcast file")
+
+// podcastSource is an ItemSource with podcast elements (i.e., an RSS item).
+type podcastSource interface {
+	GetPodcastChapters() *podcast.Chapters
+	GetPodcastTranscripts() podcast.Transcripts
+}
+
+// GetPodcastChapters returns the <podcast:chapters> of the Item (if any), which links to a chapters file for the
+// episode that can be fetched with FetchChapters. For formats other than RSS, nil is returned.
+func (i *Item) GetPodcastChapters() *podcast.Chapters {
+	if source, ok := i.ItemSource.(podcastSource); ok {
+		return source.GetPodcastChapters()
+	}
+	return nil
+}
+
+// GetPodcastTranscripts returns the <podcast:transcript> elements of the Item, which link to transcripts of the episode
+// that can be fetched with FetchTranscript. For formats other than RSS, nil is returned.
+func (i *Item) GetPodcastTranscripts() podcast.Transcripts {
+	if source, ok := i.ItemSource.(podcastSource); ok {
+		return source.GetPodcastTranscripts()
+	}
+	return nil
+}
+
+// FetchChapters fetches and parses the chapters file linked to by the given <podcast:chapters>. See
+// podcast.ParseChapters.
+//
+// The given client is used to fetch the file. If it is nil, a default client is used.
+func FetchChapters(
+	ctx context.Context, client *resty.Client, chapters *podcast.Chapters,
+) (*podcast.ChaptersDocument, error) {
+	data, _, err := fetchPodcastFile(ctx, client, chapters.URL)
+	if err != nil {
+		return nil, err
+	}
+	document, err := podcast.ParseChapters(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPodcastFetch, err)
+	}
+	return document, nil
+}
+
+// FetchTranscript fetches and parses the transcript file linked to by the given <podcast:transcript>. The format of the
+// file is determined by the type of the transcript or, if it has none, the Content-Type it is served with. See
+// podcast.ParseTranscript.
+//
+// The given client is used to fetch the file. If it is nil, a default client is used.
+func FetchTranscript(
+	ctx context.Context, client *resty.Client, transcript *podcast.Transcript,
+) (*podcast.TranscriptDocument, error) {
+	data, contentType, err := fetchPodcastFile(ctx, client, transcript.URL)
+	if err != nil {
+		return nil, err
+	}
+	mimeType := transcript.Type
+	if strings.TrimSpace(mimeType) == "" {
+		mimeType = contentType
+	}
+	document, err := podcast.ParseTranscript(data, mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPodcastFetch, err)
+	}
+	return document, nil
+}
+
+// fetchPodcastFile fetches the file at the given URL, returning its contents and Content-Type.
+func fetchPodcastFile(ctx context.Context, client *resty.Client, fileURL string) ([]byte, string, error) {
+	if !isHTTPURL(strings.TrimSpace(fileURL)) {
+		return nil, "", fmt.Errorf("%w: not an HTTP(S) URL: %q", ErrPodcastFetch, fileURL)
+	}
+	if client == nil {
+		client = types.NewClient()
+	}
+	resp, err := client.R().SetContext(ctx).Get(strings.TrimSpace(fileURL))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrPodcastFetch, err)
+	}
+	if resp.IsError() {
+		return nil, "", fmt.Errorf("%w: %w", ErrPodcastFetch,
+			&StatusError{Status: resp.Status(), StatusCode: resp.StatusCode()})
+	}
+	return resp.Body(), resp.Header().Get("Content-Type"), nil
+}
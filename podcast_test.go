@@ -0,0 +1,100 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/extensions/podcast"
+)
+
+func TestFetchPodcastFiles(t *testing.T) {
+	mux := http.NewServeMux()
+	serve := func(path, contentType, data string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write([]byte(data))
+		})
+	}
+	serve("/chapters.json", "application/json+chapters", `{"version":"1.2.0","chapters":[`+
+		`{"startTime":90.5,"title":"Interview","url":"https://example.com/guest"},`+
+		`{"startTime":0,"title":"Intro"},{"startTime":60,"img":"https://example.com/ad.png","toc":false}]}`)
+	serve("/transcript.srt", "application/octet-stream", "1\r\n00:00:00,000 --> 00:00:02,500\r\n<i>Hello</i>\r\n"+
+		"and welcome.\r\n\r\n2\r\n00:00:02,500 --> 00:01:00,000\r\nToday &amp; tomorrow.\r\n")
+	serve("/transcript.vtt", "text/vtt; charset=utf-8", "WEBVTT\n\nNOTE a comment --> ignored\n\n"+
+		"intro\n00:01.000 --> 00:04.000 align:start\n<v.loud Alice>Hi, <b>Bob</b>.</v>\n\n"+
+		"01:00:00.000 --> 01:00:01.250\n<v Bob>Bye.\n")
+	serve("/transcript.json", "application/json", `{"version":"1.0.0","segments":[`+
+		`{"speaker":"Alice","startTime":0,"endTime":1.5,"body":"Hi."}]}`)
+	serve("/transcript.html", "text/html", `<html><body><p>Hi.</p></body></html>`)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	data := `<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">` +
+		`<channel><title>Podcast</title><item><title>Episode</title>` +
+		`<podcast:chapters url="` + server.URL + `/chapters.json" type="application/json+chapters"/>` +
+		`<podcast:transcript url="` + server.URL + `/transcript.srt" type="application/x-subrip"/>` +
+		`<podcast:transcript url="` + server.URL + `/transcript.vtt" type="text/vtt" language="en" rel="captions"/>` +
+		`</item></channel></rss>`
+	feed, err := NewFeedFromBytes([]byte(data))
+	require.NoError(t, err)
+	item := feed.GetItems()[0]
+
+	chaptersLink := item.GetPodcastChapters()
+	require.NotNil(t, chaptersLink)
+	chapters, err := FetchChapters(context.Background(), nil, chaptersLink)
+	require.NoError(t, err)
+	require.Len(t, chapters.Chapters, 3)
+	assert.Equal(t, "Intro", chapters.Chapters[0].Title)
+	assert.False(t, chapters.Chapters[1].InTableOfContents())
+	assert.True(t, chapters.Chapters[2].InTableOfContents())
+	assert.Equal(t, 90*time.Second+500*time.Millisecond, chapters.Chapters[2].GetStartTime())
+
+	transcripts := item.GetPodcastTranscripts()
+	require.Len(t, transcripts, 2)
+	assert.Equal(t, "captions", transcripts[1].Rel)
+	srt, err := FetchTranscript(context.Background(), nil, &transcripts[0])
+	require.NoError(t, err)
+	assert.Equal(t, []podcast.TranscriptSegment{
+		{StartTime: 0, EndTime: 2.5, Body: "Hello and welcome."},
+		{StartTime: 2.5, EndTime: 60, Body: "Today & tomorrow."},
+	}, srt.Segments)
+	vtt, err := FetchTranscript(context.Background(), nil, &transcripts[1])
+	require.NoError(t, err)
+	assert.Equal(t, []podcast.TranscriptSegment{
+		{Speaker: "Alice", StartTime: 1, EndTime: 4, Body: "Hi, Bob."},
+		{Speaker: "Bob", StartTime: 3600, EndTime: 3601.25, Body: "Bye."},
+	}, vtt.Segments)
+
+	// Without a type, the format is determined by the Content-Type or the data.
+	tests := map[string]struct {
+		path    string
+		want    int
+		wantErr error
+	}{
+		"json":        {path: "/transcript.json", want: 1},
+		"sniffed srt": {path: "/transcript.srt", want: 2},
+		"html":        {path: "/transcript.html", wantErr: podcast.ErrUnsupportedTranscript},
+		"not found":   {path: "/missing.vtt", wantErr: ErrPodcastFetch},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			link := &podcast.Transcript{URL: server.URL + tt.path}
+			transcript, err := FetchTranscript(context.Background(), nil, link)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, transcript.Segments, tt.want)
+		})
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// ErrInvalid indicates a message is not a valid feed or item.
+var ErrInvalid = errors.New("invalid message")
+
+// ToProto converts the given Feed, including its items, into its normalized protobuf form.
+func ToProto(feed *feeds.Feed) *Feed {
+	msg := &Feed{
+		SourceType:   string(feed.SourceType),
+		Title:        feed.GetTitle(),
+		Description:  feed.GetDescription(),
+		Link:         feed.GetLink(),
+		SourceUrl:    feed.GetSourceURL(),
+		Language:     feed.GetLanguage(),
+		Published:    toTimestamp(feed.GetPublishedDate()),
+		Updated:      toTimestamp(feed.GetUpdatedDate()),
+		Image:        toImage(feed.GetImage()),
+		Categories:   feed.GetCategories(),
+		Authors:      feed.GetAuthors(),
+		Contributors: feed.GetContributors(),
+		Rights:       feed.GetRights(),
+	}
+	if interval := feed.GetUpdateInterval(); interval > 0 {
+		msg.UpdateInterval = durationpb.New(interval)
+	}
+	for item := range feed.ItemsSeq() {
+		msg.Items = append(msg.Items, ItemToProto(&item))
+	}
+	return msg
+}
+
+// ItemToProto converts the given Item into its normalized protobuf form.
+func ItemToProto(item *feeds.Item) *Item {
+	return &Item{
+		SourceType:   string(item.SourceType),
+		Id:           item.GetID(),
+		Title:        item.GetTitle(),
+		Description:  item.GetDescription(),
+		Content:      item.GetContent(),
+		Link:         item.GetLink(),
+		Published:    toTimestamp(item.GetPublishedDate()),
+		Updated:      toTimestamp(item.GetUpdatedDate()),
+		Image:        toImage(item.GetImage()),
+		Categories:   item.GetCategories(),
+		Authors:      item.GetAuthors(),
+		Contributors: item.GetContributors(),
+		Rights:       item.GetRights(),
+		Language:     item.GetLanguage(),
+		FeedTitle:    item.FeedTitle,
+	}
+}
+
+// FromProto converts the given message into a Feed. The Feed is backed by the message rather than a source document,
+// so it can be used through the methods of Feed, but cannot be encoded back into its original format.
+func FromProto(msg *Feed) *feeds.Feed {
+	feed := feeds.NewFeedFromSource[types.FeedSource](&feedSource{msg: msg})
+	feed.SourceType = types.SourceType(msg.GetSourceType())
+	return feed
+}
+
+// ItemFromProto converts the given message into an Item. The Item is backed by the message rather than a source
+// document, so it can be used through the methods of Item, but cannot be encoded back into its original format.
+func ItemFromProto(msg *Item) *feeds.Item {
+	return &feeds.Item{
+		ItemSource: &itemSource{msg: msg},
+		SourceType: types.SourceType(msg.GetSourceType()),
+		FeedTitle:  msg.GetFeedTitle(),
+	}
+}
+
+// feedSource adapts a Feed message to the types.FeedSource interface.
+type feedSource struct {
+	msg *Feed
+}
+
+func (f *feedSource) GetTitle() string {
+	return f.msg.GetTitle()
+}
+
+func (f *feedSource) GetDescription() string {
+	return f.msg.GetDescription()
+}
+
+func (f *feedSource) GetLink() string {
+	return f.msg.GetLink()
+}
+
+func (f *feedSource) GetPublishedDate() *time.Time {
+	return fromTimestamp(f.msg.GetPublished())
+}
+
+func (f *feedSource) GetUpdatedDate() *time.Time {
+	return fromTimestamp(f.msg.GetUpdated())
+}
+
+func (f *feedSource) GetAuthors() []string {
+	return f.msg.GetAuthors()
+}
+
+func (f *feedSource) GetContributors() []string {
+	return f.msg.GetContributors()
+}
+
+func (f *feedSource) GetRights() *string {
+	return f.msg.Rights
+}
+
+func (f *feedSource) GetLanguage() *string {
+	return f.msg.Language
+}
+
+func (f *feedSource) GetCategories() []string {
+	return f.msg.GetCategories()
+}
+
+func (f *feedSource) GetImage() *types.ImageInfo {
+	return fromImage(f.msg.GetImage())
+}
+
+func (f *feedSource) GetSourceURL() string {
+	return f.msg.GetSourceUrl()
+}
+
+func (f *feedSource) SetSourceURL(url string) {
+	f.msg.SourceUrl = url
+}
+
+func (f *feedSource) SetImage(image *types.ImageInfo) {
+	f.msg.Image = toImage(image)
+}
+
+func (f *feedSource) SetTitle(title string) {
+	f.msg.Title = title
+}
+
+func (f *feedSource) SetDescription(description string) {
+	f.msg.Description = description
+}
+
+func (f *feedSource) SetLink(link string) {
+	f.msg.Link = link
+}
+
+func (f *feedSource) SetPublishedDate(date time.Time) {
+	f.msg.Published = toTimestampValue(date)
+}
+
+func (f *feedSource) AddCategory(category string) {
+	f.msg.Categories = append(f.msg.Categories, category)
+}
+
+// AddItem appends the given item, which must be backed by an Item message (see ItemFromProto), to the message.
+func (f *feedSource) AddItem(item types.ItemSource) error {
+	source, ok := item.(*itemSource)
+	if !ok {
+		return fmt.Errorf("%w: cannot add %T to a feed message", types.ErrIncompatibleItem, item)
+	}
+	f.msg.Items = append(f.msg.Items, source.msg)
+	return nil
+}
+
+func (f *feedSource) GetUpdateInterval() time.Duration {
+	return f.msg.GetUpdateInterval().AsDuration()
+}
+
+func (f *feedSource) GetItems() []types.ItemSource {
+	return slices.Collect(f.ItemsSeq())
+}
+
+func (f *feedSource) ItemsSeq() iter.Seq[types.ItemSource] {
+	return func(yield func(types.ItemSource) bool) {
+		for item := range slices.Values(f.msg.GetItems()) {
+			if !yield(&itemSource{msg: item}) {
+				return
+			}
+		}
+	}
+}
+
+// Validate checks the message has the values required of any feed.
+func (f *feedSource) Validate() error {
+	if f.msg.GetTitle() == "" {
+		return fmt.Errorf("%w: feed has no title", ErrInvalid)
+	}
+	return nil
+}
+
+// itemSource adapts an Item message to the types.ItemSource interface.
+type itemSource struct {
+	msg *Item
+}
+
+func (i *itemSource) GetID() string {
+	return i.msg.GetId()
+}
+
+func (i *itemSource) GetTitle() string {
+	return i.msg.GetTitle()
+}
+
+func (i *itemSource) GetDescription() string {
+	return i.msg.GetDescription()
+}
+
+func (i *itemSource) GetContent() *string {
+	return i.msg.Content
+}
+
+func (i *itemSource) GetLink() string {
+	return i.msg.GetLink()
+}
+
+func (i *itemSource) GetPublishedDate() *time.Time {
+	return fromTimestamp(i.msg.GetPublished())
+}
+
+func (i *itemSource) GetUpdatedDate() *time.Time {
+	return fromTimestamp(i.msg.GetUpdated())
+}
+
+func (i *itemSource) GetAuthors() []string {
+	return i.msg.GetAuthors()
+}
+
+func (i *itemSource) GetContributors() []string {
+	return i.msg.GetContributors()
+}
+
+func (i *itemSource) GetRights() *string {
+	return i.msg.Rights
+}
+
+func (i *itemSource) GetLanguage() *string {
+	return i.msg.Language
+}
+
+func (i *itemSource) GetCategories() []string {
+	return i.msg.GetCategories()
+}
+
+func (i *itemSource) GetImage() *types.ImageInfo {
+	return fromImage(i.msg.GetImage())
+}
+
+func (i *itemSource) SetTitle(title string) {
+	i.msg.Title = title
+}
+
+func (i *itemSource) SetDescription(description string) {
+	i.msg.Description = description
+}
+
+func (i *itemSource) SetLink(link string) {
+	i.msg.Link = link
+}
+
+func (i *itemSource) SetPublishedDate(date time.Time) {
+	i.msg.Published = toTimestampValue(date)
+}
+
+func (i *itemSource) AddCategory(category string) {
+	i.msg.Categories = append(i.msg.Categories, category)
+}
+
+// toTimestampValue converts the given time value into a Timestamp message, or nil if it is zero.
+func toTimestampValue(value time.Time) *timestamppb.Timestamp {
+	if value.IsZero() {
+		return nil
+	}
+	return timestamppb.New(value)
+}
+
+// toTimestamp converts the given time into a Timestamp message, or nil if there is no time.
+func toTimestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(*value)
+}
+
+// fromTimestamp converts the given Timestamp message into a time, or nil if there is no timestamp.
+func fromTimestamp(value *timestamppb.Timestamp) *time.Time {
+	if value == nil {
+		return nil
+	}
+	return new(value.AsTime())
+}
+
+// toImage converts the given image into an Image message, or nil if there is no image.
+func toImage(image *types.ImageInfo) *Image {
+	if image == nil {
+		return nil
+	}
+	return &Image{Url: image.URL, Title: image.Title}
+}
+
+// fromImage converts the given Image message into an image, or nil if there is no image.
+func fromImage(image *Image) *types.ImageInfo {
+	if image == nil {
+		return nil
+	}
+	return &types.ImageInfo{URL: image.GetUrl(), Title: image.GetTitle()}
+}
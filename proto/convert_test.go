@@ -0,0 +1,72 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package proto
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+
+	feeds "github.com/immanent-tech/go-syndication"
+	"github.com/immanent-tech/go-syndication/corpus"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestConvert(t *testing.T) {
+	samples := []corpus.Sample{
+		{Name: "rss", Data: corpus.RSS(3)},
+		{Name: "atom", Data: corpus.Atom(3)},
+		{Name: "podcast", Data: corpus.Podcast(3)},
+		{Name: "jsonfeed", Data: corpus.JSONFeed(3)},
+	}
+	for sample := range slices.Values(samples) {
+		t.Run(sample.Name, func(t *testing.T) {
+			feed, err := feeds.NewFeedFromBytes(sample.Data)
+			require.NoError(t, err)
+
+			data, err := protobuf.Marshal(ToProto(feed))
+			require.NoError(t, err)
+			var msg Feed
+			require.NoError(t, protobuf.Unmarshal(data, &msg))
+
+			got := FromProto(&msg)
+			require.NoError(t, got.Validate())
+			assert.Equal(t, feed.SourceType, got.SourceType)
+			assert.Equal(t, feed.GetTitle(), got.GetTitle())
+			assert.Equal(t, feed.GetLink(), got.GetLink())
+			assert.Equal(t, feed.GetLanguage(), got.GetLanguage())
+			assert.Equal(t, feed.GetImage(), got.GetImage())
+			require.Len(t, got.GetItems(), len(feed.GetItems()))
+			for idx, item := range got.GetItems() {
+				want := feed.GetItems()[idx]
+				assert.Equal(t, want.GetID(), item.GetID())
+				assert.Equal(t, want.GetTitle(), item.GetTitle())
+				assert.Equal(t, want.GetContent(), item.GetContent())
+				assert.ElementsMatch(t, want.GetCategories(), item.GetCategories())
+				assert.True(t, want.GetPublishedDate().Equal(*item.GetPublishedDate()))
+				assert.Equal(t, feed.GetTitle(), item.FeedTitle)
+			}
+		})
+	}
+}
+
+func TestItemConvert(t *testing.T) {
+	feed, err := feeds.NewFeedFromBytes(corpus.RSS(1))
+	require.NoError(t, err)
+	item := feed.GetItems()[0]
+
+	got := ItemFromProto(ItemToProto(&item))
+	assert.Equal(t, types.SourceTypeRSS, got.SourceType)
+	assert.Equal(t, item.FeedTitle, got.FeedTitle)
+	assert.Equal(t, item.GetLink(), got.GetLink())
+	assert.Equal(t, item.GetAuthors(), got.GetAuthors())
+	assert.Nil(t, got.GetUpdatedDate())
+}
+
+func TestFromProtoInvalid(t *testing.T) {
+	require.ErrorIs(t, FromProto(&Feed{}).Validate(), ErrInvalid)
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+// Package proto contains the protobuf definition of the normalized feed and item model, with converters to and from the
+// generic feeds.Feed and feeds.Item types, for services exchanging feed data over gRPC.
+package proto
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative feeds.proto
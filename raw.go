@@ -0,0 +1,124 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// rawSource holds the raw data a Feed was decoded from, retained with the WithRawSource option.
+type rawSource struct {
+	// document is the whole document.
+	document []byte
+	// items are the portions of the document for each item, in document order.
+	items [][]byte
+}
+
+// readRawSource reads the whole document from the given io.Reader, enforcing any configured byte budget, and splits it
+// into the raw data of each item. If isJSON is true, the document is a JSONFeed, otherwise it is an XML feed.
+func readRawSource(rd io.Reader, isJSON bool, cfg *config) (*rawSource, error) {
+	document, err := io.ReadAll(cfg.limitReader(rd))
+	if err != nil {
+		return nil, fmt.Errorf("read feed: %w", err)
+	}
+	raw := &rawSource{document: document}
+	if isJSON {
+		raw.items = rawJSONItems(document)
+	} else {
+		raw.items = rawXMLItems(document)
+	}
+	return raw, nil
+}
+
+// rawXMLItems returns the portions of the given XML document for each item (i.e., each <item> or <entry> element).
+// The offsets of the items are only known for UTF-8 documents, so nil is returned for documents in any other encoding,
+// or that cannot be tokenized.
+func rawXMLItems(document []byte) [][]byte {
+	// No CharsetReader is set, so that the offsets are into the document itself. Documents declaring another encoding
+	// will fail to tokenize.
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	decoder.Strict = false
+
+	var (
+		items [][]byte
+		open  []string
+		start int64
+	)
+	itemDepth := -1
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return items
+		}
+		if err != nil {
+			return nil
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if itemDepth < 0 && len(open) > 0 &&
+				slices.Contains(itemElements, element.Name.Local) &&
+				slices.Contains(itemParentElements, open[len(open)-1]) {
+				itemDepth = len(open)
+				start = offset
+			}
+			open = append(open, element.Name.Local)
+		case xml.EndElement:
+			if len(open) > 0 {
+				open = open[:len(open)-1]
+			}
+			if len(open) == itemDepth {
+				itemDepth = -1
+				end := decoder.InputOffset()
+				items = append(items, document[start:end:end])
+			}
+		}
+	}
+}
+
+// rawJSONItems returns the portions of the given JSONFeed document for each item (i.e., each object in the items
+// array). If the document cannot be decoded, nil is returned.
+func rawJSONItems(document []byte) [][]byte {
+	var feed struct {
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(document, &feed); err != nil {
+		return nil
+	}
+	items := make([][]byte, 0, len(feed.Items))
+	for item := range slices.Values(feed.Items) {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Raw returns the raw data of the whole document the Feed was decoded from, if the Feed was created with the
+// WithRawSource option. Otherwise, nil is returned. The returned data must not be modified.
+func (f *Feed) Raw() []byte {
+	if f.raw == nil {
+		return nil
+	}
+	return f.raw.document
+}
+
+// rawItem returns the raw data of the item at the given index in the Feed, if known.
+func (f *Feed) rawItem(idx int) []byte {
+	if f.raw == nil || idx >= len(f.raw.items) {
+		return nil
+	}
+	return f.raw.items[idx]
+}
+
+// Raw returns the raw data of the Item (i.e., its untouched <item> or <entry> element, or its object in the items
+// array of a JSONFeed), if the Feed it belongs to was created with the WithRawSource option. Otherwise, or if the raw
+// data of the Item is not known, nil is returned. The returned data must not be modified.
+func (i *Item) Raw() []byte {
+	return i.raw
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		options   []Option
+		wantItems []string
+	}{
+		{
+			name: "rss",
+			data: `<?xml version="1.0"?><rss version="2.0"><channel><title>Example</title>` +
+				`<item><title>A</title><guid>a</guid></item>` + "\n" +
+				`<item><title><![CDATA[B & C]]></title><guid>b</guid></item></channel></rss>`,
+			options: []Option{WithRawSource()},
+			wantItems: []string{
+				`<item><title>A</title><guid>a</guid></item>`,
+				`<item><title><![CDATA[B & C]]></title><guid>b</guid></item>`,
+			},
+		},
+		{
+			name: "atom with item limit",
+			data: `<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title>` +
+				`<entry><id>a</id><title type="html">A</title></entry><entry><id>b</id></entry></feed>`,
+			options:   []Option{WithRawSource(), WithMaxItems(1)},
+			wantItems: []string{`<entry><id>a</id><title type="html">A</title></entry>`},
+		},
+		{
+			name:      "jsonfeed",
+			data:      `{"version":"https://jsonfeed.org/version/1.1","title":"Example","items":[{"id":"a", "title":"A"}]}`,
+			options:   []Option{WithRawSource()},
+			wantItems: []string{`{"id":"a", "title":"A"}`},
+		},
+		{
+			name:      "not retained",
+			data:      `<rss version="2.0"><channel><title>Example</title><item><guid>a</guid></item></channel></rss>`,
+			wantItems: []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data), tt.options...)
+			require.NoError(t, err)
+			if len(tt.options) > 0 {
+				assert.Equal(t, tt.data, string(feed.Raw()))
+			} else {
+				assert.Nil(t, feed.Raw())
+			}
+			var items []string
+			for item := range feed.ItemsSeq() {
+				items = append(items, string(item.Raw()))
+			}
+			assert.Equal(t, tt.wantItems, items)
+		})
+	}
+}
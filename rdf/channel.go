@@ -6,6 +6,8 @@ package rdf
 import (
 	"strings"
 	"time"
+
+	"github.com/immanent-tech/go-syndication/extensions/dc"
 )
 
 func (c *Channel) GetAuthors() []string {
@@ -56,6 +58,44 @@ func (c *Channel) SetSourceURL(value string) {
 	c.About = value
 }
 
+func (c *Channel) SetTitle(title string) {
+	c.Title = title
+}
+
+func (c *Channel) SetDescription(description string) {
+	c.Description = description
+}
+
+func (c *Channel) SetLink(link string) {
+	c.Link = link
+}
+
+// SetPublishedDate sets the <dc:date> of the Channel. A zero date removes it.
+func (c *Channel) SetPublishedDate(date time.Time) {
+	c.Date = newDate(date)
+}
+
+// AddCategory adds a <dc:subject> to the Channel.
+func (c *Channel) AddCategory(category string) {
+	c.Subject = addSubject(c.Subject, category)
+}
+
+// newDate creates a <dc:date> value for the given date, or nil if it is zero.
+func newDate(date time.Time) *dc.Date {
+	if date.IsZero() {
+		return nil
+	}
+	return &dc.Date{{Value: date, Precision: dc.PrecisionSecond}}
+}
+
+// addSubject returns the given <dc:subject> values with the given subject added.
+func addSubject(subjects *dc.Subject, subject string) *dc.Subject {
+	if subjects == nil {
+		return &dc.Subject{subject}
+	}
+	return new(append(*subjects, subject))
+}
+
 func (c *Channel) GetPublishedDate() *time.Time {
 	if c.Date != nil {
 		v := (*c.Date)[0].Value
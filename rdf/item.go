@@ -75,6 +75,32 @@ func (i *Item) GetPublishedDate() *time.Time {
 	return nil
 }
 
+func (i *Item) SetTitle(title string) {
+	i.Title = title
+}
+
+func (i *Item) SetDescription(description string) {
+	i.Description = &description
+}
+
+// SetLink sets the <link> of the Item. If the rdf:about URI of the Item was its link, it is changed too.
+func (i *Item) SetLink(link string) {
+	if i.About == "" || i.About == i.Link {
+		i.About = link
+	}
+	i.Link = link
+}
+
+// SetPublishedDate sets the <dc:date> of the Item. A zero date removes it.
+func (i *Item) SetPublishedDate(date time.Time) {
+	i.Date = newDate(date)
+}
+
+// AddCategory adds a <dc:subject> to the Item.
+func (i *Item) AddCategory(category string) {
+	i.Subject = addSubject(i.Subject, category)
+}
+
 func (i *Item) GetUpdatedDate() *time.Time {
 	return nil
 }
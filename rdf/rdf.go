@@ -7,6 +7,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"iter"
 	"slices"
 	"sort"
 	"strings"
@@ -84,6 +85,37 @@ func (r *RDF) SetImage(img *types.ImageInfo) {
 	r.Image.URL = img.GetURL()
 }
 
+func (r *RDF) SetTitle(title string) {
+	r.Channel.SetTitle(title)
+}
+
+func (r *RDF) SetDescription(description string) {
+	r.Channel.SetDescription(description)
+}
+
+func (r *RDF) SetLink(link string) {
+	r.Channel.SetLink(link)
+}
+
+func (r *RDF) SetPublishedDate(date time.Time) {
+	r.Channel.SetPublishedDate(date)
+}
+
+func (r *RDF) AddCategory(category string) {
+	r.Channel.AddCategory(category)
+}
+
+// AddItem appends the given item, which must be an *Item, to the RDF, and adds it to the <items> of the channel.
+func (r *RDF) AddItem(item types.ItemSource) error {
+	rdfItem, ok := item.(*Item)
+	if !ok {
+		return fmt.Errorf("%w: cannot add %T to an RDF feed", types.ErrIncompatibleItem, item)
+	}
+	r.Items = append(r.Items, *rdfItem)
+	r.Channel.Items = append(r.Channel.Items, rdfItem.About)
+	return nil
+}
+
 func (r *RDF) GetSourceURL() string {
 	return r.Channel.GetSourceURL()
 }
@@ -92,14 +124,27 @@ func (r *RDF) SetSourceURL(value string) {
 	r.Channel.SetSourceURL(value)
 }
 
+// GetItems returns a slice of Item values for the RDF. The values point to the items of the RDF, so any changes made
+// to them are reflected in the RDF.
 func (r *RDF) GetItems() []types.ItemSource {
 	items := make([]types.ItemSource, 0, len(r.Items))
-	for item := range slices.Values(r.Items) {
-		items = append(items, &item)
+	for idx := range r.Items {
+		items = append(items, &r.Items[idx])
 	}
 	return items
 }
 
+// ItemsSeq returns an iterator over the Item values of the RDF. Unlike GetItems, it does not allocate a slice.
+func (r *RDF) ItemsSeq() iter.Seq[types.ItemSource] {
+	return func(yield func(types.ItemSource) bool) {
+		for idx := range r.Items {
+			if !yield(&r.Items[idx]) {
+				return
+			}
+		}
+	}
+}
+
 func (r *RDF) GetUpdateInterval() time.Duration {
 	if interval := r.Channel.GetUpdateInterval(); interval > 0 {
 		return interval
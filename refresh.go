@@ -0,0 +1,206 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// DefaultItemHistory is the maximum number of items kept by Feed.Refresh, unless changed with the WithItemHistory
+// option.
+const DefaultItemHistory = 100
+
+// RefreshResult reports what changed when a Feed was refreshed with Feed.Refresh.
+type RefreshResult struct {
+	// Added contains the items that were not in the Feed before it was refreshed.
+	Added []Item
+	// Updated contains the items that were in the Feed before it was refreshed, but whose title, description, content,
+	// link or updated date has changed.
+	Updated []Item
+	// Retained contains the items that are no longer in the fetched feed, but were kept from before it was refreshed.
+	Retained []Item
+	// Dropped contains the items that were in the Feed before it was refreshed, but were not kept, either because they
+	// are no longer in the fetched feed and the item history is full, or because the format of the feed changed.
+	Dropped []Item
+	// MetadataChanged is true if the title, description, link, language, image or updated date of the Feed changed.
+	MetadataChanged bool
+}
+
+// Changed reports whether the refresh changed the Feed in any way.
+func (r *RefreshResult) Changed() bool {
+	return len(r.Added) > 0 || len(r.Updated) > 0 || len(r.Dropped) > 0 || r.MetadataChanged
+}
+
+// Refresh fetches the Feed again from its source URL and updates it in place. The metadata of the Feed is replaced with
+// that of the fetched feed, and its items are merged with those already known: items in the fetched feed come first,
+// followed by previously known items that have since rotated out of the fetched feed, so that they are not lost. The
+// number of items kept is bounded, see WithItemHistory. Items are matched by their key, see Item.Key.
+//
+// Options can be passed to configure the fetch and the merge. They are applied on top of the options the Feed was
+// created with, for this refresh only. If the WithFetchState option is used and the server reports the feed has not
+// been modified, the Feed is left as is and an empty RefreshResult is returned. Refresh is not safe to call
+// concurrently with any other method of the Feed.
+func (f *Feed) Refresh(ctx context.Context, options ...Option) (*RefreshResult, error) {
+	sourceURL := f.GetSourceURL()
+	if sourceURL == "" {
+		return nil, fmt.Errorf("%w: feed has no source URL", ErrFetch)
+	}
+	cfg := f.config.with(options...)
+	if cfg.itemHistory <= 0 {
+		cfg.itemHistory = DefaultItemHistory
+	}
+
+	fetched, err := fetchFeed(ctx, sourceURL, cfg)
+	if errors.Is(err, ErrNotModified) {
+		return &RefreshResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RefreshResult{
+		MetadataChanged: metadataChanged(f.FeedSource, fetched.FeedSource),
+	}
+	previousItems := f.GetItems()
+	known := make(map[string]Item, len(previousItems))
+	for item := range slices.Values(previousItems) {
+		known[item.Key()] = item
+	}
+	inFetched := make(map[string]bool)
+	for item := range fetched.FeedSource.ItemsSeq() {
+		inFetched[itemKey(item)] = true
+	}
+
+	mergeSource(fetched.FeedSource, f.FeedSource, cfg.itemHistory)
+	f.FeedSource = fetched.FeedSource
+	f.SourceType = fetched.SourceType
+	f.validation = &validationResult{}
+	// The fetched items come first, so the raw data of the fetched feed still matches them.
+	f.raw = fetched.raw
+	f.contentType = fetched.contentType
+	f.fetchURLs = fetched.fetchURLs
+	f.repairs = fetched.repairs
+	f.stats = fetched.stats
+
+	kept := make(map[string]bool, len(known))
+	for item := range f.ItemsSeq() {
+		key := item.Key()
+		kept[key] = true
+		previous, wasKnown := known[key]
+		switch {
+		case !wasKnown:
+			result.Added = append(result.Added, item)
+		case !inFetched[key]:
+			result.Retained = append(result.Retained, item)
+		case itemChanged(previous.ItemSource, item.ItemSource):
+			result.Updated = append(result.Updated, item)
+		}
+	}
+	for item := range slices.Values(previousItems) {
+		if !kept[item.Key()] {
+			result.Dropped = append(result.Dropped, item)
+		}
+	}
+
+	return result, nil
+}
+
+// itemChanged reports whether the given items, which are different versions of the same item, differ.
+func itemChanged(previous, current types.ItemSource) bool {
+	return previous.GetTitle() != current.GetTitle() ||
+		previous.GetDescription() != current.GetDescription() ||
+		previous.GetLink() != current.GetLink() ||
+		!equalPtr(previous.GetContent(), current.GetContent()) ||
+		!equalDate(previous.GetUpdatedDate(), current.GetUpdatedDate())
+}
+
+// metadataChanged reports whether the metadata of the given feeds, which are different versions of the same feed,
+// differ.
+func metadataChanged(previous, current types.FeedSource) bool {
+	var previousImage, currentImage string
+	if image := previous.GetImage(); image != nil {
+		previousImage = image.URL
+	}
+	if image := current.GetImage(); image != nil {
+		currentImage = image.URL
+	}
+	return previous.GetTitle() != current.GetTitle() ||
+		previous.GetDescription() != current.GetDescription() ||
+		previous.GetLink() != current.GetLink() ||
+		previousImage != currentImage ||
+		!equalPtr(previous.GetLanguage(), current.GetLanguage()) ||
+		!equalDate(previous.GetUpdatedDate(), current.GetUpdatedDate())
+}
+
+// mergeSource merges the items of the known source into the fetched source, keeping at most limit items in total
+// (though all fetched items are always kept). Items are only merged if both sources are of the same format.
+func mergeSource(fetched, known types.FeedSource, limit int) {
+	switch source := fetched.(type) {
+	case *rss.RSS:
+		if previous, ok := known.(*rss.RSS); ok {
+			source.Channel.Items = mergeItems(source.Channel.Items, previous.Channel.Items, limit)
+		}
+	case *atom.Feed:
+		if previous, ok := known.(*atom.Feed); ok {
+			source.Entries = mergeItems(source.Entries, previous.Entries, limit)
+		}
+	case *rdf.RDF:
+		if previous, ok := known.(*rdf.RDF); ok {
+			source.Items = mergeItems(source.Items, previous.Items, limit)
+		}
+	case *jsonfeed.Feed:
+		if previous, ok := known.(*jsonfeed.Feed); ok {
+			source.Items = mergeItems(source.Items, previous.Items, limit)
+		}
+	}
+}
+
+// mergeItems appends the known items that are not among the fetched items to the fetched items, in their existing
+// order, until there are limit items.
+func mergeItems[T any, PT interface {
+	*T
+	types.ItemSource
+}](fetched, known []T, limit int) []T {
+	seen := make(map[string]bool, len(fetched))
+	for idx := range fetched {
+		seen[itemKey(PT(&fetched[idx]))] = true
+	}
+	merged := fetched
+	for idx := range known {
+		if len(merged) >= limit {
+			break
+		}
+		if key := itemKey(PT(&known[idx])); !seen[key] {
+			seen[key] = true
+			merged = append(merged, known[idx])
+		}
+	}
+	return merged
+}
+
+// equalPtr reports whether the given pointers are both nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalDate reports whether the given dates are both nil or are the same instant.
+func equalDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+// refreshVersions are the successive versions of the feed served by newRefreshServer. Each item is a GUID and title.
+var refreshVersions = [][][2]string{
+	{{"a", "A"}, {"b", "B"}, {"c", "C"}},
+	{{"d", "D"}, {"a", "A (updated)"}, {"b", "B"}},
+}
+
+// newRefreshServer starts a server that serves the next of refreshVersions on each request.
+func newRefreshServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		version := refreshVersions[min(int(requests.Add(1))-1, len(refreshVersions)-1)]
+		var items strings.Builder
+		for _, item := range version {
+			fmt.Fprintf(&items, "<item><guid>%s</guid><title>%s</title></item>", item[0], item[1])
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Example Feed</title>`+
+			`<link>https://example.com/</link><description>Example</description>`+items.String()+`</channel></rss>`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFeedRefresh(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      []Option
+		wantTitles   []string
+		wantRetained []string
+		wantDropped  []string
+	}{
+		{
+			name:         "default history",
+			wantTitles:   []string{"D", "A (updated)", "B", "C"},
+			wantRetained: []string{"C"},
+		},
+		{
+			name:        "bounded history",
+			options:     []Option{WithItemHistory(3)},
+			wantTitles:  []string{"D", "A (updated)", "B"},
+			wantDropped: []string{"C"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRefreshServer(t)
+			feed, err := NewFeedFromURL(t.Context(), server.URL)
+			require.NoError(t, err)
+			assert.Equal(t, server.URL, feed.GetSourceURL())
+
+			result, err := feed.Refresh(t.Context(), tt.options...)
+			require.NoError(t, err)
+			assert.True(t, result.Changed())
+			assert.False(t, result.MetadataChanged)
+			assert.Equal(t, []string{"D"}, itemTitles(result.Added))
+			assert.Equal(t, []string{"A (updated)"}, itemTitles(result.Updated))
+			assert.Equal(t, tt.wantRetained, nilIfEmpty(itemTitles(result.Retained)))
+			assert.Equal(t, tt.wantDropped, nilIfEmpty(itemTitles(result.Dropped)))
+			assert.Equal(t, tt.wantTitles, itemTitles(feed.GetItems()))
+
+			// Refreshing again with an unchanged feed reports no changes.
+			result, err = feed.Refresh(t.Context(), tt.options...)
+			require.NoError(t, err)
+			assert.False(t, result.Changed())
+			assert.Equal(t, tt.wantTitles, itemTitles(feed.GetItems()))
+		})
+	}
+}
+
+func TestFeedRefreshNoSourceURL(t *testing.T) {
+	feed := NewFeedFromSource(rss.NewRSS("Example Feed", "Example", "https://example.com/"))
+	_, err := feed.Refresh(t.Context())
+	require.ErrorIs(t, err, ErrFetch)
+}
+
+// nilIfEmpty returns nil for an empty slice, for comparing against unset expectations.
+func nilIfEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// RepairKind is a kind of breakage repaired in an XML feed, see WithRepair.
+type RepairKind string
+
+const (
+	// RepairEncoding is an encoding declaration that did not match the encoding of the document, or bytes that were not
+	// valid in the declared encoding, which were corrected.
+	RepairEncoding RepairKind = "encoding"
+	// RepairEntity is an ampersand that did not start a reference, which was escaped, or a reference to an entity that
+	// XML does not define (e.g., the HTML entity &nbsp;), which was replaced with a reference to its character.
+	RepairEntity RepairKind = "entity"
+	// RepairControlCharacter is a character that is not allowed in XML, such as a control character, or a reference to
+	// one, which was removed.
+	RepairControlCharacter RepairKind = "control_character"
+)
+
+// Repair describes breakage that was repaired in an XML feed before it was decoded, see WithRepair.
+type Repair struct {
+	// Kind is the kind of breakage.
+	Kind RepairKind `json:"kind"`
+	// Detail describes the first occurrence of the breakage, such as the declared encoding or the invalid entity.
+	Detail string `json:"detail"`
+	// Count is the number of occurrences of the breakage that were repaired.
+	Count int `json:"count"`
+}
+
+var (
+	// xmlEncodingDecl matches the encoding declaration of an XML document, capturing the name of the encoding.
+	xmlEncodingDecl = regexp.MustCompile(`^\s*<\?xml[^>]*?\sencoding\s*=\s*["']([^"']*)["']`)
+	// xmlReference matches a character or entity reference at the start of some data.
+	xmlReference = regexp.MustCompile(`^&(#[0-9]{1,8}|#[xX][0-9a-fA-F]{1,8}|[A-Za-z_:][A-Za-z0-9._:-]{0,31});`)
+)
+
+// xmlEntities are the entities that XML defines.
+var xmlEntities = []string{"amp", "lt", "gt", "quot", "apos"}
+
+// utf8BOM is the byte order mark that some UTF-8 documents start with.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// Repairs returns the breakage that was repaired in the Feed before it was decoded, with the WithRepair option. It is
+// empty if the Feed was decoded without the option, or needed no repairs.
+func (f *Feed) Repairs() []Repair {
+	return slices.Clone(f.repairs)
+}
+
+// repairSource reads the whole document from the given io.Reader, enforcing any configured byte budget, and repairs it
+// if it is an XML document, as described by WithRepair. It returns a reader of the repaired document and the config to
+// decode it with, which does not repair it or enforce the byte budget again.
+func repairSource(rd io.Reader, cfg *config) (io.Reader, *config, []Repair, error) {
+	document, err := io.ReadAll(cfg.limitReader(rd))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("read feed: %w", err)
+	}
+	document, repairs := repairXML(document)
+	decodeCfg := cfg.with()
+	decodeCfg.repair = false
+	decodeCfg.maxBytes = 0
+	return bytes.NewReader(document), decodeCfg, repairs, nil
+}
+
+// repairXML repairs common breakage in the given XML document, returning the repaired document and the repairs made.
+// JSON documents and documents in UTF-16 are returned as is.
+func repairXML(document []byte) ([]byte, []Repair) {
+	if looksLikeJSON(document) || hasUTF16BOM(document) {
+		return document, nil
+	}
+	var repairs []Repair
+	document, repair := repairEncoding(document)
+	if repair != nil {
+		repairs = append(repairs, *repair)
+	}
+	document, markupRepairs := repairMarkup(document)
+	return document, append(repairs, markupRepairs...)
+}
+
+// repairEncoding corrects the encoding of the given document, so that it can be decoded. A declared encoding that is
+// unknown, or that is not the encoding of a document that is valid UTF-8, is replaced with UTF-8. Bytes in a UTF-8
+// document that are not valid UTF-8 are assumed to be Windows-1252, the most common mislabeled encoding, and
+// converted.
+func repairEncoding(document []byte) ([]byte, *Repair) {
+	body := bytes.TrimPrefix(document, utf8BOM)
+	var declared string
+	match := xmlEncodingDecl.FindSubmatchIndex(body)
+	if match != nil {
+		declared = string(body[match[2]:match[3]])
+	}
+	valid := utf8.Valid(body)
+	var detail string
+	switch label := strings.ToLower(strings.TrimSpace(declared)); {
+	case label == "" || label == "utf-8" || label == "utf8":
+		if valid {
+			return document, nil
+		}
+	default:
+		encoding, name := charset.Lookup(label)
+		switch {
+		case encoding == nil:
+			detail = fmt.Sprintf("declared unknown encoding %q", declared)
+		case strings.HasPrefix(name, "utf-16"):
+			// The declaration could be read as ASCII, so the document is not UTF-16.
+			detail = fmt.Sprintf("declared %s but not encoded as it", declared)
+		case valid && !isASCII(body):
+			detail = fmt.Sprintf("declared %s but encoded as UTF-8", declared)
+		default:
+			return document, nil
+		}
+	}
+
+	repaired := make([]byte, 0, len(document)+len(document)/16)
+	if len(body) < len(document) {
+		repaired = append(repaired, utf8BOM...)
+	}
+	if match != nil && declared != "" {
+		repaired = append(repaired, body[:match[2]]...)
+		repaired = append(repaired, "UTF-8"...)
+		body = body[match[3]:]
+	}
+	count := 1
+	if !valid {
+		var converted int
+		repaired, converted = appendWindows1252(repaired, body)
+		if detail == "" {
+			detail = "invalid UTF-8, converted from Windows-1252"
+			count = converted
+		}
+	} else {
+		repaired = append(repaired, body...)
+	}
+	return repaired, &Repair{Kind: RepairEncoding, Detail: detail, Count: count}
+}
+
+// appendWindows1252 appends the given data to the given UTF-8 buffer, converting any bytes that are not valid UTF-8
+// from Windows-1252. It returns the buffer and the number of bytes that were converted.
+func appendWindows1252(buf, data []byte) ([]byte, int) {
+	encoding, _ := charset.Lookup("windows-1252")
+	decoder := encoding.NewDecoder()
+	var converted int
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 {
+			if decoded, err := decoder.Bytes(data[:1]); err == nil {
+				buf = append(buf, decoded...)
+			}
+			converted++
+		} else {
+			buf = append(buf, data[:size]...)
+		}
+		data = data[size:]
+	}
+	return buf, converted
+}
+
+// repairMarkup repairs the references and characters of the given document. Ampersands that do not start a reference
+// are escaped, references to entities XML does not define are replaced with references to their characters, and
+// characters (and references to characters) that are not allowed in XML are removed. References in CDATA sections,
+// comments and processing instructions are left as is.
+func repairMarkup(document []byte) ([]byte, []Repair) {
+	entities := Repair{Kind: RepairEntity}
+	controls := Repair{Kind: RepairControlCharacter}
+	record := func(repair *Repair, detail string) {
+		if repair.Count == 0 {
+			repair.Detail = detail
+		}
+		repair.Count++
+	}
+
+	repaired := make([]byte, 0, len(document))
+	for i := 0; i < len(document); {
+		if end := markupSectionEnd(document[i:]); end > 0 {
+			for b := range slices.Values(document[i : i+end]) {
+				if isControlByte(b) {
+					record(&controls, fmt.Sprintf("U+%04X", b))
+					continue
+				}
+				repaired = append(repaired, b)
+			}
+			i += end
+			continue
+		}
+		b := document[i]
+		switch {
+		case isControlByte(b):
+			record(&controls, fmt.Sprintf("U+%04X", b))
+			i++
+		case b == '&':
+			reference := xmlReference.Find(document[i:])
+			if reference == nil {
+				record(&entities, "&")
+				repaired = append(repaired, "&amp;"...)
+				i++
+				continue
+			}
+			i += len(reference)
+			name := string(reference[1 : len(reference)-1])
+			switch {
+			case strings.HasPrefix(name, "#"):
+				if r, ok := parseCharReference(name); !ok || !isXMLChar(r) {
+					record(&controls, string(reference))
+					continue
+				}
+				repaired = append(repaired, reference...)
+			case slices.Contains(xmlEntities, name):
+				repaired = append(repaired, reference...)
+			default:
+				record(&entities, string(reference))
+				if unescaped := html.UnescapeString(string(reference)); unescaped != string(reference) {
+					for _, r := range unescaped {
+						repaired = fmt.Appendf(repaired, "&#%d;", r)
+					}
+				} else {
+					repaired = append(repaired, "&amp;"...)
+					repaired = append(repaired, reference[1:]...)
+				}
+			}
+		default:
+			repaired = append(repaired, b)
+			i++
+		}
+	}
+
+	var repairs []Repair
+	for repair := range slices.Values([]Repair{entities, controls}) {
+		if repair.Count > 0 {
+			repairs = append(repairs, repair)
+		}
+	}
+	return repaired, repairs
+}
+
+// markupSectionEnd returns the length of the CDATA section, comment or processing instruction at the start of the given
+// data, up to the end of the data if it is not terminated, or zero if there is none.
+func markupSectionEnd(data []byte) int {
+	for section := range slices.Values([][2]string{{"<![CDATA[", "]]>"}, {"<!--", "-->"}, {"<?", "?>"}}) {
+		if !bytes.HasPrefix(data, []byte(section[0])) {
+			continue
+		}
+		if end := bytes.Index(data[len(section[0]):], []byte(section[1])); end >= 0 {
+			return len(section[0]) + end + len(section[1])
+		}
+		return len(data)
+	}
+	return 0
+}
+
+// parseCharReference parses the given character reference (e.g., "#38" or "#x26"), without its ampersand and
+// semicolon.
+func parseCharReference(reference string) (rune, bool) {
+	base, digits := 10, reference[1:]
+	if strings.HasPrefix(digits, "x") || strings.HasPrefix(digits, "X") {
+		base, digits = 16, digits[1:]
+	}
+	value, err := strconv.ParseUint(digits, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rune(value), true
+}
+
+// isControlByte reports whether the given byte is a control character that is not allowed in XML. Such bytes never
+// occur within multi-byte UTF-8 sequences, nor in the other ASCII-compatible encodings feeds use.
+func isControlByte(b byte) bool {
+	return b < 0x20 && b != '\t' && b != '\n' && b != '\r'
+}
+
+// isXMLChar reports whether the given character is allowed in XML.
+func isXMLChar(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		r >= 0x20 && r <= 0xd7ff ||
+		r >= 0xe000 && r <= 0xfffd ||
+		r >= 0x10000 && r <= 0x10ffff
+}
+
+// isASCII reports whether the given data only contains ASCII characters.
+func isASCII(data []byte) bool {
+	return !slices.ContainsFunc(data, func(b byte) bool {
+		return b >= utf8.RuneSelf
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedFromBytesRepair(t *testing.T) {
+	rssWithTitle := func(title string) string {
+		return `<rss version="2.0"><channel><title>` + title + `</title><link>https://example.com/?a=1&b=2</link>` +
+			`<item><title>Item</title><description><![CDATA[<p>Fish &chips;</p>]]></description></item></channel></rss>`
+	}
+	tests := map[string]struct {
+		data        string
+		wantTitle   string
+		wantRepairs []Repair
+	}{
+		"valid references": {
+			data:      rssWithTitle("Example &amp; &#233;"),
+			wantTitle: "Example & é",
+			wantRepairs: []Repair{
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+			},
+		},
+		"html entities": {
+			data:      rssWithTitle("Caf&eacute;&nbsp;&unknown; &amp; more"),
+			wantTitle: "Café &unknown; & more",
+			wantRepairs: []Repair{
+				{Kind: RepairEntity, Detail: "&eacute;", Count: 4},
+			},
+		},
+		"control characters": {
+			data:      rssWithTitle("Tab\tvertical\x0btab&#0;&#x1b;"),
+			wantTitle: "Tab\tverticaltab",
+			wantRepairs: []Repair{
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+				{Kind: RepairControlCharacter, Detail: "U+000B", Count: 3},
+			},
+		},
+		"invalid utf-8": {
+			data:      rssWithTitle("Caf\xe9 \x93quoted\x94 café"),
+			wantTitle: "Café “quoted” café",
+			wantRepairs: []Repair{
+				{Kind: RepairEncoding, Detail: "invalid UTF-8, converted from Windows-1252", Count: 3},
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+			},
+		},
+		"unknown encoding": {
+			data:      `<?xml version="1.0" encoding="utf8x"?>` + rssWithTitle("Café"),
+			wantTitle: "Café",
+			wantRepairs: []Repair{
+				{Kind: RepairEncoding, Detail: `declared unknown encoding "utf8x"`, Count: 1},
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+			},
+		},
+		"mislabeled encoding": {
+			data:      `<?xml version="1.0" encoding="ISO-8859-1"?>` + rssWithTitle("Café"),
+			wantTitle: "Café",
+			wantRepairs: []Repair{
+				{Kind: RepairEncoding, Detail: "declared ISO-8859-1 but encoded as UTF-8", Count: 1},
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+			},
+		},
+		"latin-1": {
+			data:      "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>" + rssWithTitle("Caf\xe9"),
+			wantTitle: "Café",
+			wantRepairs: []Repair{
+				{Kind: RepairEntity, Detail: "&", Count: 1},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(tt.data), WithRepair())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, feed.GetTitle())
+			assert.Equal(t, "https://example.com/?a=1&b=2", feed.GetLink())
+			assert.Equal(t, tt.wantRepairs, feed.Repairs())
+			// CDATA sections are not repaired.
+			assert.Contains(t, feed.GetItems()[0].GetDescription(), "&chips;")
+		})
+	}
+
+	// Without the option, the feed is not repaired.
+	_, err := NewFeedFromBytes([]byte(rssWithTitle("vertical\x0btab")))
+	require.ErrorIs(t, err, ErrParseBytes)
+	feed, err := NewFeedFromBytes([]byte(rssWithTitle("Example")))
+	require.NoError(t, err)
+	assert.Empty(t, feed.Repairs())
+
+	// JSONFeed is not repaired.
+	feed, err = NewFeedFromBytes([]byte(`{"version":"https://jsonfeed.org/version/1.1","title":"A & B"}`), WithRepair())
+	require.NoError(t, err)
+	assert.Equal(t, "A & B", feed.GetTitle())
+	assert.Empty(t, feed.Repairs())
+}
@@ -5,10 +5,13 @@ package rss
 
 import (
 	"fmt"
+	"iter"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/extensions/itunes"
 	"github.com/immanent-tech/go-syndication/types"
 	"github.com/immanent-tech/go-syndication/validation"
 )
@@ -31,21 +34,46 @@ func (c *Channel) GetDescription() string {
 	return c.Description
 }
 
-// GetSourceURL retrieves the URL that links to the RSS file for the channel. This will be any <atom:link> element
+// GetSourceURL retrieves the URL that links to the RSS file for the channel. This will be the first <atom:link> element
 // present in the Channel with a "rel" attribute of "self".
 func (c *Channel) GetSourceURL() string {
-	if c.AtomLink != nil {
-		if c.AtomLink.Rel != "" && c.AtomLink.Rel == atom.LinkRelSelf {
-			return c.AtomLink.Href
-		}
+	if link := c.GetAtomLink(atom.LinkRelSelf); link != nil {
+		return link.Href
 	}
 	return ""
 }
 
-// SetSourceURL will set a source URL, indicating the URL to the RSS file, in the Channel.
+// GetAtomLinks returns the <atom:link> elements of the Channel with the given "rel" attribute (e.g., "self", "hub" or
+// "next"), in the order they appear. Relations are compared case-insensitively.
+func (c *Channel) GetAtomLinks(rel atom.LinkRel) []AtomLink {
+	var links []AtomLink
+	for link := range slices.Values(c.AtomLinks) {
+		if strings.EqualFold(string(link.Rel), string(rel)) {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// GetAtomLink returns the first <atom:link> element of the Channel with the given "rel" attribute, or nil if there is
+// none. The returned link points to the link in the Channel, so any changes made to it are reflected in the Channel.
+func (c *Channel) GetAtomLink(rel atom.LinkRel) *AtomLink {
+	idx := slices.IndexFunc(c.AtomLinks, func(link AtomLink) bool {
+		return strings.EqualFold(string(link.Rel), string(rel))
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &c.AtomLinks[idx]
+}
+
+// SetSourceURL will set a source URL, indicating the URL to the RSS file, in the Channel. Any existing <atom:link>
+// elements with a "rel" attribute of "self" are replaced, while other <atom:link> elements are kept.
 func (c *Channel) SetSourceURL(url string) {
-	rel := atom.LinkRelSelf
-	c.AtomLink = &atom.Link{Href: url, Rel: rel}
+	c.AtomLinks = slices.DeleteFunc(c.AtomLinks, func(link AtomLink) bool {
+		return link.Rel == atom.LinkRelSelf
+	})
+	c.AtomLinks = slices.Insert(c.AtomLinks, 0, atom.Link{Href: url, Rel: atom.LinkRelSelf})
 }
 
 // GetLink retrieves the <link> (if any) of the Channel. This is the link to the website associated with the RSS feed.
@@ -140,6 +168,45 @@ func (c *Channel) SetImage(image *types.ImageInfo) {
 	c.Image = &Image{URL: image.GetURL(), Title: image.GetTitle()}
 }
 
+// SetTitle sets the <title> of the Channel.
+func (c *Channel) SetTitle(title string) {
+	c.Title = title
+}
+
+// SetDescription sets the <description> of the Channel.
+func (c *Channel) SetDescription(description string) {
+	c.Description = description
+}
+
+// SetLink sets the <link> of the Channel, the link to the website associated with the RSS feed.
+func (c *Channel) SetLink(link string) {
+	c.Link = link
+}
+
+// SetPublishedDate sets the <pubDate> of the Channel. A zero date removes it.
+func (c *Channel) SetPublishedDate(date time.Time) {
+	if date.IsZero() {
+		c.PubDate = nil
+		return
+	}
+	c.PubDate = NewTimestamp(date)
+}
+
+// AddCategory adds a <category> to the Channel.
+func (c *Channel) AddCategory(category string) {
+	c.Categories = append(c.Categories, Category{Value: category})
+}
+
+// AddItem appends the given item, which must be an *Item, to the Channel.
+func (c *Channel) AddItem(item types.ItemSource) error {
+	rssItem, ok := item.(*Item)
+	if !ok {
+		return fmt.Errorf("%w: cannot add %T to an RSS channel", types.ErrIncompatibleItem, item)
+	}
+	c.Items = append(c.Items, *rssItem)
+	return nil
+}
+
 // GetPublishedDate returns the <pubDate> of the Item (if any). If there is no publish date, it will return a
 // DateTime equal to Unix epoch.
 func (c *Channel) GetPublishedDate() *time.Time {
@@ -155,12 +222,15 @@ func (c *Channel) GetUpdatedDate() *time.Time {
 	if c.LastBuildDate != nil {
 		return &c.LastBuildDate.Value
 	}
-	if len(c.Items) > 0 {
-		slices.SortFunc(c.Items, func(a, b Item) int {
-			return a.GetPublishedDate().Compare(*b.GetPublishedDate())
-		})
-		slices.Reverse(c.Items)
-		return c.Items[0].GetPublishedDate()
+	// Otherwise, use the most recent published date of the items, without reordering them.
+	var latest *time.Time
+	for idx := range c.Items {
+		if published := c.Items[idx].GetPublishedDate(); published != nil && (latest == nil || published.After(*latest)) {
+			latest = published
+		}
+	}
+	if latest != nil {
+		return latest
 	}
 	return c.GetPublishedDate()
 }
@@ -205,15 +275,136 @@ func (c *Channel) GetUpdateInterval() time.Duration {
 	return DefaultFeedUpdateInterval
 }
 
-// GetItems retrieves a slice of Item values for the Channel.
+// GetCloud returns the <cloud> of the Channel (if any), which describes an rssCloud web service that processes can
+// register with to be notified of updates to the Channel.
+func (c *Channel) GetCloud() *Cloud {
+	return c.Cloud
+}
+
+// GetTextInput returns the <textInput> of the Channel (if any), which describes a text input box, such as for searching
+// the associated website, that can be displayed with the Channel.
+func (c *Channel) GetTextInput() *TextInput {
+	return c.TextInput
+}
+
+// GetRating returns the <rating> of the Channel, which is its PICS rating, or an empty string if it has none.
+func (c *Channel) GetRating() string {
+	if c.Rating == nil {
+		return ""
+	}
+	return strings.TrimSpace(*c.Rating)
+}
+
+// GetTTL returns the <ttl> of the Channel, which is how long the Channel can be cached before it should be fetched
+// again. If the Channel has no <ttl>, zero is returned.
+func (c *Channel) GetTTL() time.Duration {
+	if c.TTL <= 0 {
+		return 0
+	}
+	return time.Duration(c.TTL) * time.Minute
+}
+
+// GetSkipHours returns the hours of the day (0-23, in GMT) listed in the <skipHours> of the Channel, during which
+// aggregators should not fetch it. Some older feeds number the hours 1-24, so an hour of 24 is treated as midnight
+// (0). Any other hours out of range are ignored.
+func (c *Channel) GetSkipHours() []int {
+	if c.SkipHours == nil {
+		return nil
+	}
+	var hours []int
+	for hour := range slices.Values(c.SkipHours.Hour) {
+		if hour == 24 {
+			hour = 0
+		}
+		if hour >= 0 && hour <= 23 && !slices.Contains(hours, hour) {
+			hours = append(hours, hour)
+		}
+	}
+	return hours
+}
+
+// GetSkipDays returns the days of the week (in GMT) listed in the <skipDays> of the Channel, during which aggregators
+// should not fetch it. Days are matched case-insensitively, and any unknown days are ignored.
+func (c *Channel) GetSkipDays() []time.Weekday {
+	if c.SkipDays == nil || c.SkipDays.Day == nil {
+		return nil
+	}
+	var days []time.Weekday
+	for day := range slices.Values(*c.SkipDays.Day) {
+		weekday, found := parseWeekday(string(day))
+		if found && !slices.Contains(days, weekday) {
+			days = append(days, weekday)
+		}
+	}
+	return days
+}
+
+// ShouldSkip reports whether aggregators should not fetch the Channel at the given time, because its hour or day (in
+// GMT) is listed in the <skipHours> or <skipDays> of the Channel.
+func (c *Channel) ShouldSkip(t time.Time) bool {
+	t = t.UTC()
+	return slices.Contains(c.GetSkipHours(), t.Hour()) || slices.Contains(c.GetSkipDays(), t.Weekday())
+}
+
+// GetItunesOwner returns the <itunes:owner> of the Channel (if any), which has the name and email address of the owner
+// of the podcast, for use by podcast directories.
+func (c *Channel) GetItunesOwner() *itunes.Owner {
+	return c.ItunesOwner
+}
+
+// IsBlocked reports whether the <itunes:block> of the Channel is set, i.e., the podcast should be hidden from podcast
+// directories.
+func (c *Channel) IsBlocked() bool {
+	return c.ItunesBlock != nil && bool(*c.ItunesBlock)
+}
+
+// IsComplete reports whether the <itunes:complete> of the Channel is set, i.e., no more episodes of the podcast will be
+// published.
+func (c *Channel) IsComplete() bool {
+	return c.ItunesComplete != nil && bool(*c.ItunesComplete)
+}
+
+// GetNewFeedURL returns the <itunes:new-feed-url> of the Channel, which is the URL the podcast has moved to, or an
+// empty string if it has none.
+func (c *Channel) GetNewFeedURL() string {
+	if c.ItunesNewFeedURL == nil {
+		return ""
+	}
+	return strings.TrimSpace(*c.ItunesNewFeedURL)
+}
+
+// parseWeekday returns the time.Weekday with the given English name, ignoring case and surrounding whitespace.
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.TrimSpace(name)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(name, weekday.String()) {
+			return weekday, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// GetItems retrieves a slice of Item values for the Channel. The values point to the items of the Channel, so any
+// changes made to them are reflected in the Channel.
 func (c *Channel) GetItems() []types.ItemSource {
 	items := make([]types.ItemSource, 0, len(c.Items))
-	for item := range slices.Values(c.Items) {
-		items = append(items, &item)
+	for idx := range c.Items {
+		items = append(items, &c.Items[idx])
 	}
 	return items
 }
 
+// ItemsSeq returns an iterator over the Item values of the Channel. Unlike GetItems, it does not allocate a slice.
+func (c *Channel) ItemsSeq() iter.Seq[types.ItemSource] {
+	return func(yield func(types.ItemSource) bool) {
+		for idx := range c.Items {
+			if !yield(&c.Items[idx]) {
+				return
+			}
+		}
+	}
+}
+
 // Validate applies custom validation to an Channel.
 func (c *Channel) Validate() error {
 	if err := validation.ValidateStruct(c); err != nil {
@@ -0,0 +1,99 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/immanent-tech/go-syndication/extensions"
+	"golang.org/x/net/html/charset"
+)
+
+// ErrNotItem indicates the data given to DecodeItem is not an <item> element.
+var ErrNotItem = errors.New("not an rss item")
+
+// DecodeItem decodes a standalone <item> element, such as one delivered by a webhook, without it having to be wrapped
+// in a <channel> and <rss> element. Fragments often use namespace prefixes (e.g., dc: or content:) without declaring
+// them, as the declarations were on the <rss> element of the document they came from. Undeclared prefixes are resolved
+// using the given namespaces, followed by extensions.WellKnownNamespaces.
+func DecodeItem(data []byte, namespaces ...extensions.Namespace) (*Item, error) {
+	prefixes := maps.Clone(extensions.WellKnownNamespaces)
+	for namespace := range slices.Values(namespaces) {
+		prefixes[namespace.Prefix] = namespace.URI
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder = xml.NewTokenDecoder(&namespaceResolver{tokens: decoder, prefixes: prefixes})
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: no element found", ErrNotItem)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode rss item: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "item" {
+			return nil, fmt.Errorf("%w: found <%s> element", ErrNotItem, start.Name.Local)
+		}
+		item := &Item{}
+		if err := decoder.DecodeElement(item, &start); err != nil {
+			return nil, fmt.Errorf("decode rss item: %w", err)
+		}
+		return item, nil
+	}
+}
+
+// namespaceResolver is an xml.TokenReader that resolves the namespace prefixes of elements and attributes that were not
+// declared in the document, which the xml.Decoder leaves as the prefix itself, to the URIs of known namespaces.
+type namespaceResolver struct {
+	tokens   xml.TokenReader
+	prefixes map[string]string
+}
+
+// Token implements xml.TokenReader.
+func (r *namespaceResolver) Token() (xml.Token, error) {
+	token, err := r.tokens.Token()
+	if err != nil {
+		return token, err
+	}
+	switch element := token.(type) {
+	case xml.StartElement:
+		element.Name = r.resolve(element.Name)
+		attrs := make([]xml.Attr, 0, len(element.Attr))
+		for attr := range slices.Values(element.Attr) {
+			if attr.Name.Space != "xmlns" {
+				attr.Name = r.resolve(attr.Name)
+			}
+			attrs = append(attrs, attr)
+		}
+		element.Attr = attrs
+		return element, nil
+	case xml.EndElement:
+		element.Name = r.resolve(element.Name)
+		return element, nil
+	default:
+		return token, nil
+	}
+}
+
+// resolve returns the given name with its namespace resolved, if it is the prefix of a known namespace.
+func (r *namespaceResolver) resolve(name xml.Name) xml.Name {
+	if uri, found := r.prefixes[name.Space]; found {
+		name.Space = uri
+	}
+	return name
+}
@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/immanent-tech/go-syndication/extensions/media"
+	"github.com/immanent-tech/go-syndication/extensions/podcast"
 	"github.com/immanent-tech/go-syndication/extensions/rss"
 	"github.com/immanent-tech/go-syndication/types"
 	"github.com/immanent-tech/go-syndication/validation"
@@ -21,6 +23,7 @@ import (
 )
 
 var _ types.ItemSource = (*Item)(nil)
+var _ types.HasComments = (*Item)(nil)
 
 // NewItem creates a new Item with the given options.
 func NewItem(options ...ItemOption) *Item {
@@ -110,9 +113,35 @@ func (i *Item) GetTitle() string {
 	return i.Title
 }
 
-// GetLink retrieves the <link> (if any) of the Item.
+// GetLink retrieves the link (if any) of the Item. This is the first found of:
+//
+//   - the <feedburner:origLink>, as feeds proxied by FeedBurner replace the <link> with a link to a redirect.
+//   - the <link>.
+//   - the <guid>, if it is a permalink (i.e., has an isPermaLink="true" attribute) and an HTTP(S) URL.
 func (i *Item) GetLink() string {
-	return i.Link
+	switch {
+	case i.FeedBurnerOrigLink != nil && strings.TrimSpace(*i.FeedBurnerOrigLink) != "":
+		return strings.TrimSpace(*i.FeedBurnerOrigLink)
+	case strings.TrimSpace(i.Link) != "":
+		return i.Link
+	case i.GUID != nil && i.GUID.IsPermaLink && isHTTPURL(strings.TrimSpace(i.GUID.Value)):
+		return strings.TrimSpace(i.GUID.Value)
+	default:
+		return ""
+	}
+}
+
+// GetCommentsURL retrieves the URL of the comments (if any) on the Item. This is the <comments> page, or if there is
+// none, the <wfw:commentRss> feed of comments.
+func (i *Item) GetCommentsURL() string {
+	switch {
+	case i.Comments != nil && strings.TrimSpace(*i.Comments) != "":
+		return strings.TrimSpace(*i.Comments)
+	case i.WFWCommentRSS != nil:
+		return strings.TrimSpace(*i.WFWCommentRSS)
+	default:
+		return ""
+	}
 }
 
 // GetDescription retrieves the <description> (if any) of the Item.
@@ -196,6 +225,9 @@ func (i *Item) GetCategories() []string {
 // This method tries to retrieve one of these, first one wins, in the order above.
 func (i *Item) GetImage() *types.ImageInfo {
 	var img *types.ImageInfo
+	imageEnclosure := slices.IndexFunc(i.Enclosures, func(enclosure Enclosure) bool {
+		return types.IsImage(enclosure.Type)
+	})
 	switch {
 	case i.Image != nil:
 		// Item has an <image> element, use it.
@@ -203,10 +235,10 @@ func (i *Item) GetImage() *types.ImageInfo {
 			URL:   i.Image.URL,
 			Title: i.Image.Title,
 		}
-	case i.Enclosure != nil && types.IsImage(i.Enclosure.Type):
-		// Item has an <enclosure> element, check if it contains an image and use it.
+	case imageEnclosure >= 0:
+		// Item has an <enclosure> element containing an image, use the first one.
 		img = &types.ImageInfo{
-			URL: i.Enclosure.URL,
+			URL: i.Enclosures[imageEnclosure].URL,
 		}
 	case i.MediaContent != nil && i.MediaContent.AsImage() != nil:
 		// Item has a <media:content> element, extract the image.
@@ -226,11 +258,109 @@ func (i *Item) GetImage() *types.ImageInfo {
 	return img
 }
 
+// SetTitle sets the <title> of the Item.
+func (i *Item) SetTitle(title string) {
+	i.Title = title
+}
+
+// SetDescription sets the <description> of the Item. Whether the existing description was written as CDATA is kept.
+func (i *Item) SetDescription(description string) {
+	i.Description = NewItemDescription(description, i.Description.CDATA)
+}
+
+// isHTTPURL reports whether the given value is an absolute HTTP(S) URL.
+func isHTTPURL(value string) bool {
+	lower := strings.ToLower(value)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// SetLink sets the <link> of the Item. Any <feedburner:origLink> is removed, as it would otherwise take precedence.
+func (i *Item) SetLink(link string) {
+	i.Link = link
+	i.FeedBurnerOrigLink = nil
+}
+
+// SetPublishedDate sets the <pubDate> of the Item. A zero date removes it.
+func (i *Item) SetPublishedDate(date time.Time) {
+	if date.IsZero() {
+		i.PubDate = nil
+		return
+	}
+	i.PubDate = NewTimestamp(date)
+}
+
+// AddCategory adds a <category> to the Item.
+func (i *Item) AddCategory(category string) {
+	i.Categories = append(i.Categories, Category{Value: category})
+}
+
 // GetMediaGroup returns any media.MediaGroup object for the entry.
 func (i *Item) GetMediaGroup() *media.MediaGroup {
 	return i.MediaGroup
 }
 
+// GetMediaCredits returns the <media:credit> elements of the Item, its <media:group> and its <media:content> elements.
+// See media.CollectCredits.
+func (i *Item) GetMediaCredits() media.MediaCredits {
+	return media.CollectCredits(i.MediaCredits, i.MediaGroup, i.mediaContents()...)
+}
+
+// GetMediaHashes returns the <media:hash> elements of the Item, its <media:group> and its <media:content> elements.
+// See media.CollectHashes.
+func (i *Item) GetMediaHashes() media.MediaHashes {
+	return media.CollectHashes(i.MediaHashes, i.MediaGroup, i.mediaContents()...)
+}
+
+// GetMediaTexts returns the <media:text> elements of the Item, its <media:group> and its <media:content> elements.
+// See media.CollectTexts.
+func (i *Item) GetMediaTexts() media.MediaTexts {
+	return media.CollectTexts(i.MediaTexts, i.MediaGroup, i.mediaContents()...)
+}
+
+// GetMediaCommunity returns the <media:community> of the Item, merged with those of its <media:group> and its
+// <media:content> elements. See media.CollectCommunity.
+func (i *Item) GetMediaCommunity() *media.MediaCommunity {
+	return media.CollectCommunity(i.MediaCommunity, i.MediaGroup, i.mediaContents()...)
+}
+
+// VerifyEnclosureHash checks the given data, such as a downloaded enclosure, against the <media:hash> elements of the
+// Item. See media.MediaHashes.Verify.
+func (i *Item) VerifyEnclosureHash(data []byte) error {
+	if err := i.GetMediaHashes().Verify(data); err != nil {
+		return fmt.Errorf("verify enclosure: %w", err)
+	}
+	return nil
+}
+
+// mediaContents returns the <media:content> element of the Item (if any).
+func (i *Item) mediaContents() []media.MediaContent {
+	if i.MediaContent == nil {
+		return nil
+	}
+	return []media.MediaContent{*i.MediaContent}
+}
+
+// GetEnclosure returns the first <enclosure> of the Item (if any). The RSS specification allows an item only one
+// enclosure, but as many feeds include several, all of them are available in the Enclosures field.
+func (i *Item) GetEnclosure() *Enclosure {
+	if len(i.Enclosures) == 0 {
+		return nil
+	}
+	return &i.Enclosures[0]
+}
+
+// GetPodcastChapters returns the <podcast:chapters> of the Item (if any), which links to a chapters file for the
+// episode. See podcast.ParseChapters.
+func (i *Item) GetPodcastChapters() *podcast.Chapters {
+	return i.PodcastChapters
+}
+
+// GetPodcastTranscripts returns the <podcast:transcript> elements of the Item, which link to transcripts of the episode
+// in different formats or languages. See podcast.ParseTranscript.
+func (i *Item) GetPodcastTranscripts() podcast.Transcripts {
+	return i.PodcastTranscripts
+}
+
 // GetPublishedDate returns the <pubDate> of the Item (if any). If there is no publish date, it will return a
 // DateTime equal to Unix epoch.
 func (i *Item) GetPublishedDate() *time.Time {
@@ -288,6 +418,38 @@ func NewGUID(value string, permalink bool) *GUID {
 	}
 }
 
+// MarshalXML implements xml.Marshaler. The isPermaLink attribute is only written if it is false, as its default value
+// is true.
+func (g GUID) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if !g.IsPermaLink {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "isPermaLink"}, Value: "false"})
+	}
+	if err := enc.EncodeElement(g.Value, start); err != nil {
+		return fmt.Errorf("guid: marshal: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler. As defined by the RSS specification, a <guid> without an isPermaLink
+// attribute is a permalink.
+func (g *GUID) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	g.IsPermaLink = true
+	for attr := range slices.Values(start.Attr) {
+		if attr.Name.Local != "isPermaLink" {
+			continue
+		}
+		permalink, err := strconv.ParseBool(strings.TrimSpace(attr.Value))
+		if err != nil {
+			return fmt.Errorf("guid: invalid isPermaLink: %w", err)
+		}
+		g.IsPermaLink = permalink
+	}
+	if err := dec.DecodeElement(&g.Value, &start); err != nil {
+		return fmt.Errorf("guid: unmarshal: %w", err)
+	}
+	return nil
+}
+
 func NewItemDescription(value string, cdata bool) ItemDescription {
 	return ItemDescription{
 		Value: value,
@@ -312,6 +474,7 @@ func (c ItemDescription) MarshalXML(enc *xml.Encoder, start xml.StartElement) er
 		}{c.Value}, start); err != nil {
 			return fmt.Errorf("encode description: %w", err)
 		}
+		return nil
 	}
 	if err := enc.EncodeElement(struct {
 		Value string `xml:",chardata"`
@@ -343,19 +506,36 @@ func (c *ItemDescription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The description is encoded as a plain string, unless it is written as CDATA,
+// in which case it is encoded as an object with the value and the cdata flag, so that it survives a round trip.
 func (c ItemDescription) MarshalJSON() ([]byte, error) {
-	data, err := json.Marshal(c.Value)
+	var data []byte
+	var err error
+	if c.CDATA {
+		data, err = json.Marshal(itemDescriptionJSON(c))
+	} else {
+		data, err = json.Marshal(c.Value)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("marshal item description: %w", err)
 	}
 	return data, nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts either encoding written by MarshalJSON.
 func (c *ItemDescription) UnmarshalJSON(data []byte) error {
 	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = ItemDescription{Value: s}
+		return nil
+	}
+	var v itemDescriptionJSON
+	if err := json.Unmarshal(data, &v); err != nil {
 		return fmt.Errorf("unmarshal item description: %w", err)
 	}
-	c.Value = s
+	*c = ItemDescription(v)
 	return nil
 }
+
+// itemDescriptionJSON is ItemDescription without its JSON methods.
+type itemDescriptionJSON ItemDescription
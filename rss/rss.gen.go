@@ -13,7 +13,8 @@ import (
 	externalRef3 "github.com/immanent-tech/go-syndication/extensions/googleplay"
 	externalRef4 "github.com/immanent-tech/go-syndication/extensions/itunes"
 	externalRef5 "github.com/immanent-tech/go-syndication/extensions/media"
-	externalRef6 "github.com/immanent-tech/go-syndication/extensions/rss"
+	externalRef6 "github.com/immanent-tech/go-syndication/extensions/podcast"
+	externalRef7 "github.com/immanent-tech/go-syndication/extensions/rss"
 )
 
 // Defines values for CloudProtocol.
@@ -115,14 +116,25 @@ type Channel struct {
 	// ItunesAuthor is the author of the show content.
 	ItunesAuthor *externalRef4.Author `json:"itunes_author" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd author,omitempty"`
 
+	// ItunesBlock indicates whether the show should be hidden from podcast directories.
+	ItunesBlock *externalRef4.Block `json:"itunes_block" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd block,omitempty"`
+
 	// ItunesCategory is the set of all taxonomies that represent the show.
 	ItunesCategory *externalRef4.Categories `json:"itunes_category" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category,omitempty"`
 
+	// ItunesComplete indicates whether the show is complete and no more episodes will be published.
+	ItunesComplete *externalRef4.Complete `json:"itunes_complete" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd complete,omitempty"`
+
 	// ItunesExplicit indicates whether the content is explicit in nature.
 	ItunesExplicit *externalRef4.Explicit `json:"itunes_explicit" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit,omitempty"`
 
 	// ItunesImage is the artwork for the show.
 	ItunesImage *externalRef4.Image `json:"itunes_image" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image,omitempty"`
+
+	// ItunesNewFeedURL is the URL the show has moved to, which should be used instead of the URL of this feed.
+	ItunesNewFeedURL *externalRef4.NewFeedURL `json:"itunes_new_feed_url" validate:"omitempty,url" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd new-feed-url,omitempty"`
+
+	// ItunesOwner is the contact information of the owner of the show, for use by podcast directories only.
 	ItunesOwner *externalRef4.Owner `json:"itunes_owner" xml:"http://www.itunes.com/dtds/podcast-1.0.dtd owner,omitempty"`
 
 	// ItunesSubtitle is a subtitle for the show content.
@@ -213,15 +225,17 @@ type Channel struct {
 	MediaTitle *externalRef5.MediaTitle `json:"media_title" xml:"http://search.yahoo.com/mrss/ title,omitempty"`
 
 	// SYUdatePeriod is the period over which the channel format is updated.
-	SYUdatePeriod *externalRef6.SYUpdatePeriod `json:"update_period,omitempty" validate:"omitempty,oneof=hourly daily weekly monthly yearly" xml:"http://purl.org/rss/1.0/modules/syndication/ updatePeriod,omitempty"`
+	SYUdatePeriod *externalRef7.SYUpdatePeriod `json:"update_period,omitempty" validate:"omitempty,oneof=hourly daily weekly monthly yearly" xml:"http://purl.org/rss/1.0/modules/syndication/ updatePeriod,omitempty"`
 
 	// SYUpdateBase is a base date to be used in concert with updatePeriod and updateFrequency to calculate the publishing schedule.
-	SYUpdateBase *externalRef6.SYUpdateBase `json:"update_base,omitempty" xml:"http://purl.org/rss/1.0/modules/syndication/ updateBase,omitempty"`
+	SYUpdateBase *externalRef7.SYUpdateBase `json:"update_base,omitempty" xml:"http://purl.org/rss/1.0/modules/syndication/ updateBase,omitempty"`
 
 	// SYUpdateFrequency describes the frequency of updates in relation to the update period.
-	SYUpdateFrequency *externalRef6.SYUpdateFrequency `json:"update_frequency,omitempty" validate:"omitempty,number,gte=1" xml:"http://purl.org/rss/1.0/modules/syndication/ updateFrequency,omitempty"`
+	SYUpdateFrequency *externalRef7.SYUpdateFrequency `json:"update_frequency,omitempty" validate:"omitempty,number,gte=1" xml:"http://purl.org/rss/1.0/modules/syndication/ updateFrequency,omitempty"`
 	XMLName           xml.Name                        `json:"XMLName" validate:"required" xml:"channel"`
-	AtomLink          *AtomLink                       `json:"atom_link" validate:"omitempty" xml:"http://www.w3.org/2005/Atom link,omitempty"`
+
+	// AtomLinks are the <atom:link> elements of the channel, such as the rel="self" link to the feed and the rel="hub" links to any WebSub hubs of the feed.
+	AtomLinks []AtomLink `json:"atom_links,omitempty" validate:"omitempty,dive" xml:"http://www.w3.org/2005/Atom link,omitempty"`
 
 	// Categories is a list of categories associated with the channel.
 	Categories []Category `json:"category,omitempty" xml:"category,omitempty"`
@@ -319,7 +333,7 @@ type Channel struct {
 	TextInput *TextInput `json:"textInput,omitempty" xml:"textInput,omitempty"`
 
 	// Title is the name of the channel. It's how people refer to your service. If you have an HTML website that contains the same information as your RSS file, the title of your channel should be the same as the title of your website.
-	Title string `json:"title" validate:"required" xml:"title"`
+	Title string `json:"title" validate:"required,nohtml" xml:"title"`
 
 	// TTL stands for time to live. It's a number of minutes that indicates how long a channel can be cached before refreshing from the source.
 	TTL TTL `json:"ttl,omitempty" validate:"omitempty,gte=1" xml:"ttl,omitempty"`
@@ -337,8 +351,8 @@ type Channel struct {
 type Cloud struct {
 	Domain            string        `json:"domain" validate:"required" xml:"domain,attr"`
 	Path              string        `json:"path" validate:"required" xml:"path,attr"`
-	Port              int           `json:"port" validate:"required" xml:"port,attr"`
-	Protocol          CloudProtocol `json:"protocol" validate:"required" xml:"protocol,attr"`
+	Port              int           `json:"port" validate:"required,gt=0,lte=65535" xml:"port,attr"`
+	Protocol          CloudProtocol `json:"protocol" validate:"required,oneof=xml-rpc soap http-post" xml:"protocol,attr"`
 	RegisterProcedure string        `json:"registerProcedure" validate:"required" xml:"registerProcedure,attr"`
 }
 
@@ -379,7 +393,7 @@ type Image struct {
 	Link string `json:"link" validate:"required_without=URL,omitempty,url" xml:"link,omitempty"`
 
 	// Title describes the image, it's used in the ALT attribute of the HTML <img> tag when the channel is rendered in HTML.
-	Title string `json:"title" validate:"required" xml:"title"`
+	Title string `json:"title" validate:"required,nohtml" xml:"title"`
 
 	// URL is the URL of a GIF, JPEG or PNG image that represents the channel.
 	URL string `json:"url" validate:"required_without=link,omitempty,url" xml:"url"`
@@ -391,7 +405,10 @@ type Image struct {
 // Item An item may represent a "story" -- much like a story in a newspaper or magazine; if so its description is a synopsis of the story, and the link points to the full story. An item may also be complete in itself, if so, the description contains the text (entity-encoded HTML is allowed; see examples), and the link and title may be omitted. All elements of an item are optional, however at least one of title or description must be present.
 type Item struct {
 	// ContentEncoded is an element whose contents are the entity-encoded or CDATA-escaped version of the content of the item.
-	ContentEncoded *externalRef6.ContentEncoded `json:"content_encoded,omitempty" xml:"http://purl.org/rss/1.0/modules/content/ encoded,omitempty"`
+	ContentEncoded *externalRef7.ContentEncoded `json:"content_encoded,omitempty" xml:"http://purl.org/rss/1.0/modules/content/ encoded,omitempty"`
+
+	// FeedBurnerOrigLink is the original link of an item in a feed proxied by FeedBurner, which replaces the link of the item with a link to its own redirect.
+	FeedBurnerOrigLink *externalRef7.FeedBurnerOrigLink `json:"feedburner_orig_link,omitempty" validate:"omitempty,url" xml:"http://rssnamespace.org/feedburner/ext/1.0 origLink,omitempty"`
 
 	// MediaBackLinks allows inclusion of all the URLs pointing to a media object.
 	MediaBackLinks externalRef5.MediaBacklinks `json:"media_backlinks,omitempty" xml:"http://search.yahoo.com/mrss/ backLink,omitempty"`
@@ -472,8 +489,17 @@ type Item struct {
 	MediaTitle *externalRef5.MediaTitle `json:"media_title" xml:"http://search.yahoo.com/mrss/ title,omitempty"`
 
 	// PermaLink is defined as a URL for a resource that is always available (similar to a PURL). Some weblogs cycle through articles and a URL may become invalid after a period of time. Permalinks provide a link that is always available to and should be provided within RSS so that clients can use this instead of a temporary link.
-	PermaLink *externalRef6.PermaLink `json:"link_permalink,omitempty" xml:"http://purl.org/rss/1.0/modules/link/ permalink,omitempty"`
-	AtomLink  *AtomLink               `json:"atom_link" validate:"omitempty" xml:"http://www.w3.org/2005/Atom link,omitempty"`
+	PermaLink *externalRef7.PermaLink `json:"link_permalink,omitempty" xml:"http://purl.org/rss/1.0/modules/link/ permalink,omitempty"`
+
+	// PodcastChapters links to the chapters of the episode.
+	PodcastChapters *externalRef6.Chapters `json:"podcast_chapters" xml:"https://podcastindex.org/namespace/1.0 chapters,omitempty"`
+
+	// PodcastTranscripts is a list of transcripts of the episode, such as in different languages or formats.
+	PodcastTranscripts externalRef6.Transcripts `json:"podcast_transcripts" xml:"https://podcastindex.org/namespace/1.0 transcript,omitempty"`
+
+	// WFWCommentRSS is the URL of a feed of the comments on an item, from the Well-Formed Web CommentAPI.
+	WFWCommentRSS *externalRef7.WFWCommentRSS `json:"wfw_comment_rss,omitempty" validate:"omitempty,url" xml:"http://wellformedweb.org/CommentAPI/ commentRss,omitempty"`
+	AtomLink      *AtomLink                   `json:"atom_link" validate:"omitempty" xml:"http://www.w3.org/2005/Atom link,omitempty"`
 
 	// Author is the email address of the author of the item. For newspapers and magazines syndicating via RSS, the author is the person who wrote the article that the <item> describes. For collaborative weblogs, the author of the item might be different from the managing editor or webmaster. For a weblog authored by a single individual it would make sense to omit the <author> element.
 	Author *Author `json:"author,omitempty" xml:"author,omitempty"`
@@ -503,8 +529,8 @@ type Item struct {
 	// Description is a short description of the item.
 	Description ItemDescription `json:"description,omitzero" validate:"required_without=Title" xml:"description"`
 
-	// Enclosure describes a media object.
-	Enclosure *Enclosure `json:"enclosure,omitempty" xml:"enclosure,omitempty"`
+	// Enclosures are the media objects attached to the item. The RSS specification allows only one, but many feeds include several.
+	Enclosures []Enclosure `json:"enclosures,omitempty" xml:"enclosure,omitempty"`
 
 	// Format is the file format, physical medium, or dimensions of the resource.
 	// Recommended practice is to use a controlled vocabulary where available. For example, for file formats one could use the list of Internet Media Types [MIME]. Examples of dimensions include size and duration.
@@ -599,7 +625,7 @@ type SkipDaysDay string
 
 // SkipHours is a hint for aggregators telling them which hours they can skip.
 type SkipHours struct {
-	Hour []int `json:"hour,omitempty" validate:"omitempty,dive,gte=0,lte=23" xml:"hour,omitempty"`
+	Hour []int `json:"hour,omitempty" validate:"omitempty,dive,gte=0,lte=23" xml:"hour"`
 }
 
 // Source The RSS channel that the item came from.
@@ -623,7 +649,7 @@ type TextInput struct {
 	Name string `json:"name" validate:"required" xml:"name"`
 
 	// Title is the label of the Submit button in the text input area.
-	Title string `json:"title" validate:"required" xml:"title"`
+	Title string `json:"title" validate:"required,nohtml" xml:"title"`
 }
 
 // Timestamp represents a timestamp for an object
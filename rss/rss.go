@@ -10,6 +10,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"iter"
 	"slices"
 	"sort"
 	"strings"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/immanent-tech/go-syndication/atom"
 	"github.com/immanent-tech/go-syndication/extensions"
+	"github.com/immanent-tech/go-syndication/extensions/itunes"
 	ext "github.com/immanent-tech/go-syndication/extensions/rss"
 
 	"github.com/immanent-tech/go-syndication/types"
@@ -87,9 +89,12 @@ func NewRSS(title, description, link string, options ...RSSOption) *RSS {
 // RSSOption is a functional applied to an RSS object.
 type RSSOption func(*RSS)
 
+// WithAtomLink adds the given <atom:link> to the channel.
 func WithAtomLink(link *atom.Link) RSSOption {
 	return func(r *RSS) {
-		r.Channel.AtomLink = link
+		if link != nil {
+			r.Channel.AtomLinks = append(r.Channel.AtomLinks, *link)
+		}
 	}
 }
 
@@ -219,14 +224,110 @@ func (r *RSS) SetImage(image *types.ImageInfo) {
 	r.Channel.SetImage(image)
 }
 
+func (r *RSS) SetTitle(title string) {
+	r.Channel.SetTitle(title)
+}
+
+func (r *RSS) SetDescription(description string) {
+	r.Channel.SetDescription(description)
+}
+
+func (r *RSS) SetLink(link string) {
+	r.Channel.SetLink(link)
+}
+
+func (r *RSS) SetPublishedDate(date time.Time) {
+	r.Channel.SetPublishedDate(date)
+}
+
+func (r *RSS) AddCategory(category string) {
+	r.Channel.AddCategory(category)
+}
+
+func (r *RSS) AddItem(item types.ItemSource) error {
+	return r.Channel.AddItem(item)
+}
+
 func (r *RSS) GetItems() []types.ItemSource {
 	return r.Channel.GetItems()
 }
 
+// ItemsSeq returns an iterator over the Item values of the Channel. Unlike GetItems, it does not allocate a slice.
+func (r *RSS) ItemsSeq() iter.Seq[types.ItemSource] {
+	return r.Channel.ItemsSeq()
+}
+
 func (r *RSS) GetUpdateInterval() time.Duration {
 	return r.Channel.GetUpdateInterval()
 }
 
+// GetCloud returns the <cloud> of the Channel of the RSS feed. See Channel.GetCloud.
+func (r *RSS) GetCloud() *Cloud {
+	return r.Channel.GetCloud()
+}
+
+// GetTextInput returns the <textInput> of the Channel of the RSS feed. See Channel.GetTextInput.
+func (r *RSS) GetTextInput() *TextInput {
+	return r.Channel.GetTextInput()
+}
+
+// GetRating returns the <rating> of the Channel of the RSS feed. See Channel.GetRating.
+func (r *RSS) GetRating() string {
+	return r.Channel.GetRating()
+}
+
+// GetTTL returns the <ttl> of the Channel of the RSS feed. See Channel.GetTTL.
+func (r *RSS) GetTTL() time.Duration {
+	return r.Channel.GetTTL()
+}
+
+// GetSkipHours returns the <skipHours> of the Channel of the RSS feed. See Channel.GetSkipHours.
+func (r *RSS) GetSkipHours() []int {
+	return r.Channel.GetSkipHours()
+}
+
+// GetSkipDays returns the <skipDays> of the Channel of the RSS feed. See Channel.GetSkipDays.
+func (r *RSS) GetSkipDays() []time.Weekday {
+	return r.Channel.GetSkipDays()
+}
+
+// ShouldSkip reports whether aggregators should not fetch the RSS feed at the given time. See Channel.ShouldSkip.
+func (r *RSS) ShouldSkip(t time.Time) bool {
+	return r.Channel.ShouldSkip(t)
+}
+
+// GetAtomLinks returns the <atom:link> elements of the Channel of the RSS feed with the given "rel" attribute. See
+// Channel.GetAtomLinks.
+func (r *RSS) GetAtomLinks(rel atom.LinkRel) []AtomLink {
+	return r.Channel.GetAtomLinks(rel)
+}
+
+// GetAtomLink returns the first <atom:link> element of the Channel of the RSS feed with the given "rel" attribute. See
+// Channel.GetAtomLink.
+func (r *RSS) GetAtomLink(rel atom.LinkRel) *AtomLink {
+	return r.Channel.GetAtomLink(rel)
+}
+
+// GetItunesOwner returns the <itunes:owner> of the Channel of the RSS feed. See Channel.GetItunesOwner.
+func (r *RSS) GetItunesOwner() *itunes.Owner {
+	return r.Channel.GetItunesOwner()
+}
+
+// IsBlocked reports whether the <itunes:block> of the Channel of the RSS feed is set. See Channel.IsBlocked.
+func (r *RSS) IsBlocked() bool {
+	return r.Channel.IsBlocked()
+}
+
+// IsComplete reports whether the <itunes:complete> of the Channel of the RSS feed is set. See Channel.IsComplete.
+func (r *RSS) IsComplete() bool {
+	return r.Channel.IsComplete()
+}
+
+// GetNewFeedURL returns the <itunes:new-feed-url> of the Channel of the RSS feed. See Channel.GetNewFeedURL.
+func (r *RSS) GetNewFeedURL() string {
+	return r.Channel.GetNewFeedURL()
+}
+
 // Validate applies custom validation to an feed.
 func (r *RSS) Validate() error {
 	if err := validation.ValidateStruct(r); err != nil {
@@ -320,7 +421,7 @@ func (r *RSS) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
 // namespace for a typed extension field you populated.
 func (r *RSS) AutoDeclareNamespaces() {
 	need := map[string]bool{}
-	if r.Channel.AtomLink != nil {
+	if len(r.Channel.AtomLinks) > 0 {
 		need["atom"] = true
 	}
 	if r.Channel.SYUdatePeriod != nil || r.Channel.SYUpdateFrequency != nil {
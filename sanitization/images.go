@@ -0,0 +1,243 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package sanitization
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	// lazySrcAttrs are the attributes commonly used by lazy-loading scripts to hold the real image source, in order of
+	// preference.
+	lazySrcAttrs = []string{"data-src", "data-lazy-src", "data-original"}
+	// lazySrcsetAttrs are the attributes commonly used by lazy-loading scripts to hold the real image srcset, in order
+	// of preference.
+	lazySrcsetAttrs = []string{"data-srcset", "data-lazy-srcset"}
+)
+
+// WithLazyImages option will normalize any lazy-loaded images in the content before sanitization. See
+// NormalizeLazyImages for details.
+func WithLazyImages() Option {
+	return func(s *config) {
+		s.lazyImages = true
+	}
+}
+
+// WithImageURLRewriter option will rewrite the URL of every image in the content with the given function before
+// sanitization. See RewriteImageURLs for details.
+func WithImageURLRewriter(rewriter func(string) string) Option {
+	return func(s *config) {
+		s.imageURLRewriter = rewriter
+	}
+}
+
+// NormalizeLazyImages will rewrite any lazy-loaded images in the given HTML content so they can be displayed without
+// the lazy-loading script of the originating site. For <img> and <source> elements:
+//
+// - the value of a data-src (or similar) attribute is promoted to the src attribute, if the src is empty or a
+// placeholder data URI.
+//
+// - the value of a data-srcset (or similar) attribute is promoted to the srcset attribute, if the srcset is empty.
+//
+// - if an <img> still has no usable src, the largest candidate in its srcset is used as the src.
+//
+// If the content cannot be parsed, it is returned unchanged.
+func NormalizeLazyImages(content string) string {
+	return transformImages(content, normalizeLazyImage)
+}
+
+// RewriteImageURLs will rewrite the src and every srcset candidate URL of any <img> and <source> elements in the given
+// HTML content with the given function. This can be used to route images through a proxy or caching service. If the
+// content cannot be parsed, it is returned unchanged.
+func RewriteImageURLs(content string, rewriter func(string) string) string {
+	return transformImages(content, func(node *html.Node) {
+		rewriteImageURLs(node, rewriter)
+	})
+}
+
+// transformImages parses the given HTML content and applies the transforms, in order, to every <img> and <source>
+// element found. The transformed content is returned. If the content cannot be parsed, it is returned unchanged.
+func transformImages(content string, transforms ...func(*html.Node)) string {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return content
+	}
+
+	var out strings.Builder
+	for node := range slices.Values(nodes) {
+		transformImage(node, transforms...)
+		for descendant := range node.Descendants() {
+			transformImage(descendant, transforms...)
+		}
+		if err := html.Render(&out, node); err != nil {
+			return content
+		}
+	}
+	return out.String()
+}
+
+// transformImage applies the transforms to the given node if it is an image element.
+func transformImage(node *html.Node, transforms ...func(*html.Node)) {
+	if node.Type != html.ElementNode || (node.DataAtom != atom.Img && node.DataAtom != atom.Source) {
+		return
+	}
+	for transform := range slices.Values(transforms) {
+		transform(node)
+	}
+}
+
+// rewriteImageURLs rewrites the src and srcset attributes of the given image element.
+func rewriteImageURLs(node *html.Node, rewriter func(string) string) {
+	if src := getAttr(node, "src"); src != "" && !strings.HasPrefix(src, "data:") {
+		setAttr(node, "src", rewriter(src))
+	}
+	if srcset := getAttr(node, "srcset"); srcset != "" {
+		candidates := parseSrcset(srcset)
+		values := make([]string, 0, len(candidates))
+		for candidate := range slices.Values(candidates) {
+			values = append(values, strings.Join(append([]string{rewriter(candidate.url)}, candidate.descriptors...), " "))
+		}
+		setAttr(node, "srcset", strings.Join(values, ", "))
+	}
+}
+
+// normalizeLazyImage normalizes the given image element.
+func normalizeLazyImage(node *html.Node) {
+	if src := getAttr(node, "src"); src == "" || strings.HasPrefix(src, "data:") {
+		for attr := range slices.Values(lazySrcAttrs) {
+			if value := getAttr(node, attr); value != "" {
+				setAttr(node, "src", value)
+				break
+			}
+		}
+	}
+	if getAttr(node, "srcset") == "" {
+		for attr := range slices.Values(lazySrcsetAttrs) {
+			if value := getAttr(node, attr); value != "" {
+				setAttr(node, "srcset", value)
+				break
+			}
+		}
+	}
+	if node.DataAtom != atom.Img {
+		return
+	}
+	if src := getAttr(node, "src"); src == "" || strings.HasPrefix(src, "data:") {
+		if candidate := bestSrcsetCandidate(getAttr(node, "srcset")); candidate != "" {
+			setAttr(node, "src", candidate)
+		}
+	}
+}
+
+// srcsetCandidate is a single image candidate from a srcset attribute.
+type srcsetCandidate struct {
+	url         string
+	descriptors []string
+	width       float64
+	density     float64
+}
+
+// parseDescriptor parses a width (w) or pixel density (x) descriptor for the candidate. Unknown or invalid
+// descriptors are ignored.
+func (c *srcsetCandidate) parseDescriptor(descriptor string) {
+	if len(descriptor) < 2 {
+		return
+	}
+	value, err := strconv.ParseFloat(descriptor[:len(descriptor)-1], 64)
+	if err != nil {
+		return
+	}
+	switch descriptor[len(descriptor)-1] {
+	case 'w':
+		c.width = value
+	case 'x':
+		c.density = value
+	}
+}
+
+// parseSrcset parses the candidates from the value of a srcset attribute. It loosely follows the parsing algorithm in
+// the HTML specification: a candidate is a URL followed by optional descriptors, terminated by a comma.
+func parseSrcset(srcset string) []srcsetCandidate {
+	var candidates []srcsetCandidate
+	for remaining := srcset; ; {
+		remaining = strings.TrimLeft(remaining, ", \t\n\r\f")
+		if remaining == "" {
+			return candidates
+		}
+		// The URL is everything up to the next whitespace.
+		end := strings.IndexFunc(remaining, unicode.IsSpace)
+		if end < 0 {
+			end = len(remaining)
+		}
+		url := remaining[:end]
+		remaining = remaining[end:]
+		candidate := srcsetCandidate{density: 1}
+		if trimmed := strings.TrimRight(url, ","); trimmed != url {
+			// A URL ending in a comma has no descriptors.
+			candidate.url = trimmed
+			candidates = append(candidates, candidate)
+			continue
+		}
+		candidate.url = url
+		// Descriptors are everything up to the next comma.
+		end = strings.IndexByte(remaining, ',')
+		if end < 0 {
+			end = len(remaining)
+		}
+		for descriptor := range strings.FieldsSeq(remaining[:end]) {
+			candidate.descriptors = append(candidate.descriptors, descriptor)
+			candidate.parseDescriptor(descriptor)
+		}
+		remaining = remaining[end:]
+		candidates = append(candidates, candidate)
+	}
+}
+
+// bestSrcsetCandidate returns the URL of the largest candidate in the given srcset attribute value, preferring width
+// descriptors over pixel density descriptors. It returns an empty string if there are no candidates.
+func bestSrcsetCandidate(srcset string) string {
+	candidates := parseSrcset(srcset)
+	if len(candidates) == 0 {
+		return ""
+	}
+	return slices.MaxFunc(candidates, func(a, b srcsetCandidate) int {
+		if a.width != b.width {
+			return cmp.Compare(a.width, b.width)
+		}
+		return cmp.Compare(a.density, b.density)
+	}).url
+}
+
+// getAttr returns the value of the given attribute on the node, or an empty string if the node does not have the
+// attribute.
+func getAttr(node *html.Node, key string) string {
+	for attr := range slices.Values(node.Attr) {
+		if attr.Namespace == "" && attr.Key == key {
+			return strings.TrimSpace(attr.Val)
+		}
+	}
+	return ""
+}
+
+// setAttr sets the value of the given attribute on the node, adding the attribute if it does not exist.
+func setAttr(node *html.Node, key, value string) {
+	for idx := range node.Attr {
+		if node.Attr[idx].Namespace == "" && node.Attr[idx].Key == key {
+			node.Attr[idx].Val = value
+			return
+		}
+	}
+	node.Attr = append(node.Attr, html.Attribute{Key: key, Val: value})
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package sanitization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLazyImages(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "data-src",
+			content: `<p><img data-src="https://example.com/a.jpg" alt="a"/></p>`,
+			want:    `<p><img data-src="https://example.com/a.jpg" alt="a" src="https://example.com/a.jpg"/></p>`,
+		},
+		{
+			name:    "placeholder src",
+			content: `<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-lazy-src="https://example.com/a.jpg"/>`,
+			want:    `<img src="https://example.com/a.jpg" data-lazy-src="https://example.com/a.jpg"/>`,
+		},
+		{
+			name:    "existing src",
+			content: `<img src="https://example.com/a.jpg" data-src="https://example.com/b.jpg"/>`,
+			want:    `<img src="https://example.com/a.jpg" data-src="https://example.com/b.jpg"/>`,
+		},
+		{
+			name:    "srcset width",
+			content: `<img data-srcset="https://example.com/a.jpg 320w, https://example.com/b.jpg 1024w,https://example.com/c.jpg 640w"/>`,
+			want:    `<img data-srcset="https://example.com/a.jpg 320w, https://example.com/b.jpg 1024w,https://example.com/c.jpg 640w" srcset="https://example.com/a.jpg 320w, https://example.com/b.jpg 1024w,https://example.com/c.jpg 640w" src="https://example.com/b.jpg"/>`,
+		},
+		{
+			name:    "srcset density",
+			content: `<img srcset="https://example.com/a.jpg, https://example.com/b.jpg 2x"/>`,
+			want:    `<img srcset="https://example.com/a.jpg, https://example.com/b.jpg 2x" src="https://example.com/b.jpg"/>`,
+		},
+		{
+			name:    "picture source",
+			content: `<picture><source data-srcset="https://example.com/a.webp"/><img data-src="https://example.com/a.jpg"/></picture>`,
+			want:    `<picture><source data-srcset="https://example.com/a.webp" srcset="https://example.com/a.webp"/><img data-src="https://example.com/a.jpg" src="https://example.com/a.jpg"/></picture>`,
+		},
+		{
+			name:    "no images",
+			content: `<p>Hello <b>world</b></p>`,
+			want:    `<p>Hello <b>world</b></p>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeLazyImages(tt.content))
+		})
+	}
+}
+
+func TestSanitizeStringWithLazyImages(t *testing.T) {
+	content := `<p><img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-src="https://example.com/a.jpg"/></p>`
+	assert.Equal(t, `<p><img src="https://example.com/a.jpg"/></p>`, SanitizeString(content, WithLazyImages()))
+	assert.Equal(t, `<p></p>`, SanitizeString(content))
+}
+
+func TestRewriteImageURLs(t *testing.T) {
+	rewriter := func(url string) string {
+		return "https://proxy.example.org/?url=" + url
+	}
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "src",
+			content: `<p><img src="https://example.com/a.jpg"/></p>`,
+			want:    `<p><img src="https://proxy.example.org/?url=https://example.com/a.jpg"/></p>`,
+		},
+		{
+			name:    "srcset",
+			content: `<picture><source srcset="https://example.com/a.webp 1x,https://example.com/b.webp 2x"/></picture>`,
+			want:    `<picture><source srcset="https://proxy.example.org/?url=https://example.com/a.webp 1x, https://proxy.example.org/?url=https://example.com/b.webp 2x"/></picture>`,
+		},
+		{
+			name:    "data uri",
+			content: `<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw="/>`,
+			want:    `<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw="/>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RewriteImageURLs(tt.content, rewriter))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package sanitization
+
+import (
+	"cmp"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// WithSortedAttributes option will sort the attributes of every element in the sanitized output by name. This ensures
+// the same content produces the same output regardless of the attribute order in the source.
+func WithSortedAttributes() Option {
+	return func(s *config) {
+		s.sortAttributes = true
+	}
+}
+
+// WithNormalizedWhitespace option will collapse any runs of whitespace in the text of the sanitized output into a single
+// space. Whitespace inside <pre> and <textarea> elements is preserved.
+func WithNormalizedWhitespace() Option {
+	return func(s *config) {
+		s.normalizeWhitespace = true
+	}
+}
+
+// WithNormalizedEntities option will re-encode the text and attribute values of the sanitized output so that
+// equivalent character references (for example, "&#39;", "&#x27;" and "'") are always output the same way. The output
+// of SanitizeString is unescaped, so its character references are always output as their characters.
+func WithNormalizedEntities() Option {
+	return func(s *config) {
+		s.normalizeEntities = true
+	}
+}
+
+// WithDeterministicOutput option ensures the sanitized output is stable for the same content, so that it can be
+// reliably hashed or compared for change detection. It is equivalent to using the WithSortedAttributes,
+// WithNormalizedWhitespace and WithNormalizedEntities options together.
+func WithDeterministicOutput() Option {
+	return func(s *config) {
+		s.sortAttributes = true
+		s.normalizeWhitespace = true
+		s.normalizeEntities = true
+	}
+}
+
+// normalizeOutput applies any output normalization options to the given sanitized content.
+func (c *config) normalizeOutput(content string) string {
+	if !c.sortAttributes && !c.normalizeWhitespace && !c.normalizeEntities {
+		return content
+	}
+
+	var (
+		out           strings.Builder
+		preserveDepth int
+	)
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if errors.Is(tokenizer.Err(), io.EOF) {
+				return out.String()
+			}
+			return content
+		}
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if tokenType == html.StartTagToken && preservesWhitespace(token.DataAtom) {
+				preserveDepth++
+			}
+			if !c.sortAttributes && !c.normalizeEntities {
+				out.WriteString(raw)
+				continue
+			}
+			if c.sortAttributes {
+				slices.SortStableFunc(token.Attr, func(a, b html.Attribute) int {
+					return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Key, b.Key))
+				})
+			}
+			out.WriteString(token.String())
+		case html.EndTagToken:
+			if preservesWhitespace(token.DataAtom) && preserveDepth > 0 {
+				preserveDepth--
+			}
+			out.WriteString(raw)
+		case html.TextToken:
+			text := raw
+			if c.normalizeWhitespace && preserveDepth == 0 {
+				text = collapseWhitespace(text)
+			}
+			if c.normalizeEntities {
+				text = html.EscapeString(html.UnescapeString(text))
+			}
+			out.WriteString(text)
+		default:
+			out.WriteString(raw)
+		}
+	}
+}
+
+// preservesWhitespace reports whether whitespace is significant within the given element.
+func preservesWhitespace(element atom.Atom) bool {
+	return element == atom.Pre || element == atom.Textarea
+}
+
+// collapseWhitespace replaces any runs of whitespace in the given text with a single space.
+func collapseWhitespace(text string) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		if text == "" {
+			return ""
+		}
+		return " "
+	}
+	collapsed := strings.Join(fields, " ")
+	if strings.TrimLeftFunc(text, unicode.IsSpace) != text {
+		collapsed = " " + collapsed
+	}
+	if strings.TrimRightFunc(text, unicode.IsSpace) != text {
+		collapsed += " "
+	}
+	return collapsed
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package sanitization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeBytesDeterministic(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		options []Option
+		want    string
+	}{
+		{
+			name:    "sorted attributes",
+			content: `<img src="https://example.com/a.jpg" alt="a" width="10">`,
+			options: []Option{WithSortedAttributes()},
+			want:    `<img alt="a" src="https://example.com/a.jpg" width="10">`,
+		},
+		{
+			name:    "normalized whitespace",
+			content: "<p>Hello \n\t <b>world</b>  !</p><pre>a\n  b</pre>",
+			options: []Option{WithNormalizedWhitespace()},
+			want:    "<p>Hello <b>world</b> !</p><pre>a\n  b</pre>",
+		},
+		{
+			name:    "normalized entities",
+			content: `<p>It&#39;s &#x27;quoted&#x27; &amp; 'plain'</p>`,
+			options: []Option{WithNormalizedEntities()},
+			want:    `<p>It&#39;s &#39;quoted&#39; &amp; &#39;plain&#39;</p>`,
+		},
+		{
+			name:    "unchanged",
+			content: `<p>It&#39;s   fine</p>`,
+			want:    `<p>It&#39;s   fine</p>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(SanitizeBytes([]byte(tt.content), tt.options...)))
+		})
+	}
+}
+
+func TestSanitizeStringDeterministic(t *testing.T) {
+	a := SanitizeString(`<p>Hello   <a title="x" href="https://example.com">world</a></p>`, WithDeterministicOutput())
+	b := SanitizeString("<p>Hello\n<a href=\"https://example.com\" title=\"x\">world</a></p>", WithDeterministicOutput())
+	assert.Equal(t, a, b)
+}
+
+func TestSanitizeStringNormalized(t *testing.T) {
+	content := "<p>It&#39;s  &#x27;quoted&#x27;\n&amp; 'plain'</p>"
+	want := `<p>It's 'quoted' & 'plain'</p>`
+	assert.Equal(t, want, SanitizeString(content, WithDeterministicOutput()))
+	assert.Equal(t, want, SanitizeString(content, WithNormalizedWhitespace()))
+	// Entities are output the same way with or without the option.
+	assert.Equal(t, SanitizeString(content, WithNormalizedEntities()), SanitizeString(content))
+}
@@ -6,8 +6,10 @@ package sanitization
 import (
 	"bytes"
 	"html"
+	"net/url"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/microcosm-cc/bluemonday"
 )
@@ -22,32 +24,146 @@ func WithPolicy(policy *bluemonday.Policy) Option {
 	}
 }
 
+// WithURLSchemes will restrict the links and images kept by the default policy to those with one of the given URL
+// schemes (e.g., "https"), rather than the http, https and mailto schemes it allows. Relative URLs are always kept.
+// Schemes are case-insensitive. With a custom policy set with WithPolicy, the output of the custom policy is sanitized
+// again with the restricted default policy, so that links and images with other schemes are removed from it too.
+func WithURLSchemes(schemes ...string) Option {
+	return func(s *config) {
+		s.urlSchemes = schemes
+	}
+}
+
+var (
+	// defaultPolicy is the policy used when no custom policy is set. Policies are safe for concurrent use once
+	// created, so a single policy is shared rather than created for every call.
+	defaultPolicy = sync.OnceValue(bluemonday.UGCPolicy)
+	// urlSchemePolicies holds the default policies restricted to particular URL schemes, keyed by the schemes.
+	urlSchemePolicies sync.Map
+	// bufferPool is a pool of buffers used to hold sanitized output.
+	bufferPool = sync.Pool{
+		New: func() any {
+			return new(bytes.Buffer)
+		},
+	}
+)
+
 // config holds configuration for sanitisation methods.
 type config struct {
-	policy *bluemonday.Policy
+	policy              *bluemonday.Policy
+	schemePolicy        *bluemonday.Policy
+	urlSchemes          []string
+	imageURLRewriter    func(string) string
+	lazyImages          bool
+	sortAttributes      bool
+	normalizeWhitespace bool
+	normalizeEntities   bool
 }
 
+// newConfig creates a config with the given options applied.
+func newConfig(options ...Option) *config {
+	cfg := &config{}
+	for option := range slices.Values(options) {
+		option(cfg)
+	}
+	switch {
+	case cfg.policy == nil:
+		cfg.policy = urlSchemePolicy(cfg.urlSchemes)
+	case len(cfg.urlSchemes) > 0:
+		// A policy cannot be copied, so the URL schemes are restricted by a second pass over the output.
+		cfg.schemePolicy = urlSchemePolicy(cfg.urlSchemes)
+	}
+	return cfg
+}
+
+// urlSchemePolicy returns the default policy, with links and images restricted to the given URL schemes, if any.
+func urlSchemePolicy(schemes []string) *bluemonday.Policy {
+	if len(schemes) == 0 {
+		return defaultPolicy()
+	}
+	normalized := make([]string, 0, len(schemes))
+	for scheme := range slices.Values(schemes) {
+		normalized = append(normalized, strings.ToLower(strings.TrimSpace(scheme)))
+	}
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	key := strings.Join(normalized, ",")
+	if policy, ok := urlSchemePolicies.Load(key); ok {
+		if policy, ok := policy.(*bluemonday.Policy); ok {
+			return policy
+		}
+	}
+	policy := bluemonday.UGCPolicy().AllowURLSchemes(normalized...)
+	for scheme := range slices.Values(ugcURLSchemes) {
+		if !slices.Contains(normalized, scheme) {
+			// A scheme allowed by the UGC policy cannot be removed, only denied by a URL policy.
+			policy.AllowURLSchemeWithCustomPolicy(scheme, func(*url.URL) bool { return false })
+		}
+	}
+	urlSchemePolicies.Store(key, policy)
+	return policy
+}
+
+// ugcURLSchemes are the URL schemes allowed by the bluemonday UGC policy.
+var ugcURLSchemes = []string{"mailto", "http", "https"}
+
 // SanitizeString attempts to "sanitize" a string value from a Feed/Item object. It will strip any leading/trailing
 // whitespace and then run the string through bluemonday to remove dangerous components. This should retain HTML5
 // content.
 func SanitizeString(str string, options ...Option) string {
-	cfg := &config{
-		policy: bluemonday.UGCPolicy(),
+	cfg := newConfig(options...)
+	if cfg.lazyImages {
+		str = NormalizeLazyImages(str)
 	}
-	for option := range slices.Values(options) {
-		option(cfg)
+	if cfg.imageURLRewriter != nil {
+		str = RewriteImageURLs(str, cfg.imageURLRewriter)
 	}
-	return strings.TrimSpace(html.UnescapeString(cfg.policy.Sanitize(str)))
+	// The output is normalized before it is unescaped, as unescaped text could be mistaken for markup. Unescaping
+	// outputs every character reference as its character, so entities are always normalized.
+	return strings.TrimSpace(html.UnescapeString(cfg.normalizeOutput(cfg.sanitize(str))))
 }
 
 // SanitizeBytes attempts to "sanitize" a []byte value from a Feed/Item object. It will strip any leading/trailing
 // whitespace and then run the string through bluemonday to remove dangerous components.
 func SanitizeBytes(data []byte, options ...Option) []byte {
-	cfg := &config{
-		policy: bluemonday.UGCPolicy(),
+	cfg := newConfig(options...)
+	if cfg.lazyImages {
+		data = []byte(NormalizeLazyImages(string(data)))
 	}
-	for option := range slices.Values(options) {
-		option(cfg)
+	if cfg.imageURLRewriter != nil {
+		data = []byte(RewriteImageURLs(string(data), cfg.imageURLRewriter))
+	}
+	sanitized := cfg.policy.SanitizeBytes(bytes.TrimSpace(data))
+	if cfg.schemePolicy != nil {
+		sanitized = cfg.schemePolicy.SanitizeBytes(sanitized)
+	}
+	if cfg.sortAttributes || cfg.normalizeWhitespace || cfg.normalizeEntities {
+		return bytes.TrimSpace([]byte(cfg.normalizeOutput(string(sanitized))))
+	}
+	return sanitized
+}
+
+// sanitize runs the given string through the policy, and then any policy restricting URL schemes.
+func (c *config) sanitize(str string) string {
+	str = sanitizeWith(c.policy, str)
+	if c.schemePolicy != nil {
+		str = sanitizeWith(c.schemePolicy, str)
+	}
+	return str
+}
+
+// sanitizeWith runs the given string through the given policy, using a pooled buffer for the output.
+func sanitizeWith(policy *bluemonday.Policy, str string) string {
+	buf, ok := bufferPool.Get().(*bytes.Buffer)
+	if !ok {
+		buf = new(bytes.Buffer)
+	}
+	defer func() {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}()
+	if err := policy.SanitizeReaderToWriter(strings.NewReader(str), buf); err != nil {
+		return policy.Sanitize(str)
 	}
-	return cfg.policy.SanitizeBytes(bytes.TrimSpace(data))
+	return buf.String()
 }
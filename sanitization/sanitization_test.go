@@ -0,0 +1,61 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package sanitization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+)
+
+var benchmarkContent = strings.Repeat(`<p>The <b>quick</b> brown fox <a href="https://example.com/" onclick="alert(1)">jumps</a> `+
+	`over the lazy dog.</p><img src="https://example.com/a.jpg" alt="a fox"><script>alert(1)</script>`, 20)
+
+func BenchmarkSanitizeString(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		SanitizeString(benchmarkContent)
+	}
+}
+
+// BenchmarkSanitizeStringNewPolicy creates a new policy for every call, for comparison against the shared default
+// policy.
+func BenchmarkSanitizeStringNewPolicy(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		SanitizeString(benchmarkContent, WithPolicy(bluemonday.UGCPolicy()))
+	}
+}
+
+func BenchmarkSanitizeBytes(b *testing.B) {
+	data := []byte(benchmarkContent)
+	b.ReportAllocs()
+	for b.Loop() {
+		SanitizeBytes(data)
+	}
+}
+
+func TestSanitizeStringURLSchemes(t *testing.T) {
+	content := `<p><a href="https://example.com/">web</a> <a href="ftp://example.com/file">ftp</a> ` +
+		`<a href="mailto:someone@example.com">mail</a></p>`
+
+	// By default, the links allowed by the UGC policy are kept.
+	assert.Equal(t, `<p><a href="https://example.com/" rel="nofollow">web</a> ftp `+
+		`<a href="mailto:someone@example.com" rel="nofollow">mail</a></p>`, SanitizeString(content))
+
+	assert.Equal(t, `<p><a href="https://example.com/" rel="nofollow">web</a> ftp mail</p>`,
+		SanitizeString(content, WithURLSchemes("HTTP", "https")))
+	assert.Equal(t, `<p><a href="https://example.com/" rel="nofollow">web</a> `+
+		`<a href="ftp://example.com/file" rel="nofollow">ftp</a> mail</p>`,
+		SanitizeString(content, WithURLSchemes("http", "https", "ftp")))
+
+	// The URL schemes also restrict the output of a custom policy.
+	policy := bluemonday.UGCPolicy().AllowURLSchemes("ftp")
+	assert.Equal(t, `<p><a href="https://example.com/" rel="nofollow">web</a> ftp mail</p>`,
+		SanitizeString(content, WithPolicy(policy), WithURLSchemes("https")))
+	assert.Equal(t, `<p><a href="https://example.com/" rel="nofollow">web</a> ftp mail</p>`,
+		string(SanitizeBytes([]byte(content), WithPolicy(policy), WithURLSchemes("https"))))
+}
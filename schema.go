@@ -0,0 +1,203 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// JSONSchemaVersion is the version of the JSON form of a Feed and Item written by this package. It is incremented
+// whenever the JSON form changes in a way that older versions of this package could not read.
+//
+// The JSON form is an envelope with the following stable fields:
+//
+//   - "schema_version": the version of the envelope, as a number.
+//   - "type": the SourceType of the source, e.g., "RSS" or "Atom".
+//   - "feed_title": for an Item only, the title of the feed containing the item.
+//   - "source": the source feed or item, in the JSON form of its source type.
+//
+// Data written without a "schema_version" field (by versions of this package before the field was introduced) is
+// treated as version 0.
+const JSONSchemaVersion = 4
+
+// jsonEnvelope is the JSON form of a Feed or Item.
+type jsonEnvelope struct {
+	SchemaVersion int              `json:"schema_version"`
+	Type          types.SourceType `json:"type"`
+	FeedTitle     string           `json:"feed_title,omitempty"`
+	Source        json.RawMessage  `json:"source"`
+}
+
+// migrations are the functions that migrate the raw fields of a JSON envelope from one schema version to the next. The
+// migration at index N migrates data from version N to version N+1.
+var migrations = []func(fields map[string]json.RawMessage) error{
+	// Version 0 has the same fields as version 1, only without a schema_version.
+	func(map[string]json.RawMessage) error { return nil },
+	// Version 2 holds the <atom:link> elements of the channel of an RSS feed in an "atom_links" array, rather than a
+	// single "atom_link" object.
+	migrateRSSAtomLinks,
+	// Version 3 holds the <enclosure> elements of an RSS item in an "enclosures" array, rather than a single "enclosure"
+	// object.
+	migrateRSSEnclosures,
+	// Version 4 writes the "description" and "content_encoded" of an RSS item as an object with a "value" and a
+	// "cdata" flag when they are written as CDATA. The plain strings of earlier versions are still read as is.
+	func(map[string]json.RawMessage) error { return nil },
+}
+
+// migrateRSSAtomLinks migrates the "atom_link" object of the channel of an RSS feed to an "atom_links" array. Other
+// sources, including RSS items, are left as is.
+func migrateRSSAtomLinks(fields map[string]json.RawMessage) error {
+	// A missing or invalid type is reported after migration.
+	var sourceType types.SourceType
+	if json.Unmarshal(fields["type"], &sourceType) != nil || sourceType != types.SourceTypeRSS {
+		return nil
+	}
+	var source map[string]json.RawMessage
+	if err := json.Unmarshal(fields["source"], &source); err != nil {
+		return err
+	}
+	// An item has no channel.
+	var channel map[string]json.RawMessage
+	if json.Unmarshal(source["channel"], &channel) != nil {
+		return nil
+	}
+	link, found := channel["atom_link"]
+	if !found {
+		return nil
+	}
+	delete(channel, "atom_link")
+	if string(link) != "null" {
+		channel["atom_links"] = append(append([]byte("["), link...), ']')
+	}
+
+	var err error
+	if source["channel"], err = json.Marshal(channel); err != nil {
+		return err
+	}
+	fields["source"], err = json.Marshal(source)
+	return err
+}
+
+// migrateRSSEnclosures migrates the "enclosure" object of each RSS item to an "enclosures" array, for both an RSS feed
+// and a single RSS item. Other sources are left as is.
+func migrateRSSEnclosures(fields map[string]json.RawMessage) error {
+	// A missing or invalid type is reported after migration.
+	var sourceType types.SourceType
+	if json.Unmarshal(fields["type"], &sourceType) != nil || sourceType != types.SourceTypeRSS {
+		return nil
+	}
+	var source map[string]json.RawMessage
+	if err := json.Unmarshal(fields["source"], &source); err != nil {
+		return err
+	}
+	var channel map[string]json.RawMessage
+	if json.Unmarshal(source["channel"], &channel) != nil {
+		// The source is a single item.
+		migrateRSSEnclosure(source)
+	} else {
+		var items []map[string]json.RawMessage
+		if json.Unmarshal(channel["items"], &items) != nil {
+			return nil
+		}
+		for item := range slices.Values(items) {
+			migrateRSSEnclosure(item)
+		}
+		var err error
+		if channel["items"], err = json.Marshal(items); err != nil {
+			return err
+		}
+		if source["channel"], err = json.Marshal(channel); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	fields["source"], err = json.Marshal(source)
+	return err
+}
+
+// migrateRSSEnclosure migrates the "enclosure" object of the given RSS item to an "enclosures" array.
+func migrateRSSEnclosure(item map[string]json.RawMessage) {
+	enclosure, found := item["enclosure"]
+	if !found {
+		return
+	}
+	delete(item, "enclosure")
+	if string(enclosure) != "null" {
+		item["enclosures"] = append(append([]byte("["), enclosure...), ']')
+	}
+}
+
+// MarshalJSON handles marshaling of a Feed to JSON, as a versioned envelope around its source.
+func (f Feed) MarshalJSON() ([]byte, error) {
+	return marshalEnvelope(f.SourceType, "", f.FeedSource)
+}
+
+// MarshalJSON handles marshaling of an Item to JSON, as a versioned envelope around its source.
+func (i Item) MarshalJSON() ([]byte, error) {
+	return marshalEnvelope(i.SourceType, i.FeedTitle, i.ItemSource)
+}
+
+// marshalEnvelope marshals the given source into a JSON envelope of the current schema version.
+func marshalEnvelope(sourceType types.SourceType, feedTitle string, source any) ([]byte, error) {
+	if sourceType == "" || sourceType == types.SourceTypeUnknown {
+		// The source could not be unmarshaled again without its type.
+		return nil, fmt.Errorf("%w: %w", ErrMarshal, &UnknownSourceError{Type: fmt.Sprintf("%T", source)})
+	}
+	data, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMarshal, err)
+	}
+	data, err = json.Marshal(jsonEnvelope{
+		SchemaVersion: JSONSchemaVersion,
+		Type:          sourceType,
+		FeedTitle:     feedTitle,
+		Source:        data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMarshal, err)
+	}
+	return data, nil
+}
+
+// decodeEnvelope decodes the given JSON data into an envelope, migrating it to the current schema version if needed.
+func decodeEnvelope(v []byte) (*jsonEnvelope, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(v, &fields); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshal, err)
+	}
+	var version int
+	if rawVersion, found := fields["schema_version"]; found {
+		if err := json.Unmarshal(rawVersion, &version); err != nil {
+			return nil, fmt.Errorf("%w: invalid schema version: %w", ErrUnmarshal, err)
+		}
+	}
+	if version < 0 || version > JSONSchemaVersion {
+		return nil, fmt.Errorf("%w: unsupported schema version %d", ErrUnmarshal, version)
+	}
+	for migrate := range slices.Values(migrations[version:]) {
+		if err := migrate(fields); err != nil {
+			return nil, fmt.Errorf("%w: migrate schema version %d: %w", ErrUnmarshal, version, err)
+		}
+		version++
+	}
+
+	if _, found := fields["type"]; !found {
+		return nil, fmt.Errorf("%w: unknown data type", ErrUnmarshal)
+	}
+	envelope := &jsonEnvelope{SchemaVersion: version, Source: fields["source"]}
+	if err := json.Unmarshal(fields["type"], &envelope.Type); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshal, err)
+	}
+	if rawTitle, found := fields["feed_title"]; found {
+		if err := json.Unmarshal(rawTitle, &envelope.FeedTitle); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnmarshal, err)
+		}
+	}
+	return envelope, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ext "github.com/immanent-tech/go-syndication/extensions/rss"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestFeedJSONSchema(t *testing.T) {
+	for format := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			feed, err := NewFeedFromBytes([]byte(streamTests[format]))
+			require.NoError(t, err)
+
+			data, err := json.Marshal(feed)
+			require.NoError(t, err)
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(data, &fields))
+			assert.JSONEq(t, "4", string(fields["schema_version"]))
+
+			var got Feed
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.Equal(t, format, got.SourceType)
+			assert.Equal(t, feed.GetTitle(), got.GetTitle())
+			assert.Len(t, got.GetItems(), len(feed.GetItems()))
+
+			items, err := json.Marshal(feed.GetItems())
+			require.NoError(t, err)
+			var gotItems []Item
+			require.NoError(t, json.Unmarshal(items, &gotItems))
+			require.Len(t, gotItems, len(feed.GetItems()))
+			assert.Equal(t, format, gotItems[0].SourceType)
+			assert.Equal(t, "Example Feed", gotItems[0].FeedTitle)
+			assert.Equal(t, feed.GetItems()[0].GetTitle(), gotItems[0].GetTitle())
+		})
+	}
+}
+
+func TestFeedJSONSchemaMigration(t *testing.T) {
+	// Data written before the schema was versioned has no schema_version.
+	var feed Feed
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"RSS","source":{"channel":{"title":"Legacy"}}}`), &feed))
+	assert.Equal(t, types.SourceTypeRSS, feed.SourceType)
+	assert.Equal(t, "Legacy", feed.GetTitle())
+
+	// Version 1 data holds the <atom:link> of an RSS channel as a single object.
+	require.NoError(t, json.Unmarshal([]byte(`{"schema_version":1,"type":"RSS","source":{"channel":{"title":"Legacy",`+
+		`"atom_link":{"href":"https://example.com/feed.xml","rel":"self"}}}}`), &feed))
+	assert.Equal(t, "https://example.com/feed.xml", feed.GetSourceURL())
+
+	// Version 2 data holds the <enclosure> of an RSS item as a single object.
+	require.NoError(t, json.Unmarshal([]byte(`{"schema_version":2,"type":"RSS","source":{"channel":{"title":"Legacy",`+
+		`"items":[{"title":"Episode","enclosure":{"url":"https://example.com/a.mp3","length":1,"type":"audio/mpeg"}}]}}}`),
+		&feed))
+	require.Len(t, feed.GetItems(), 1)
+	assert.Len(t, feed.GetItems()[0].GetEnclosures(), 1)
+	var item Item
+	require.NoError(t, json.Unmarshal([]byte(`{"schema_version":2,"type":"RSS","feed_title":"Legacy","source":{`+
+		`"title":"Episode","enclosure":{"url":"https://example.com/a.mp3","length":1,"type":"audio/mpeg"}}}`), &item))
+	assert.Len(t, item.GetEnclosures(), 1)
+
+	// Data from a newer version of the schema cannot be read.
+	err := json.Unmarshal([]byte(`{"schema_version":99,"type":"RSS","source":{}}`), &feed)
+	require.ErrorIs(t, err, ErrUnmarshal)
+}
+
+func TestFeedJSONRoundTrip(t *testing.T) {
+	// Every extension fixture that decodes should survive a round trip through JSON, both as a feed and item by item.
+	files, err := filepath.Glob(filepath.Join("test", "assets", "ext", "*", "*.xml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for file := range slices.Values(files) {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		feed, err := NewFeedFromBytes(data)
+		if err != nil {
+			continue
+		}
+		t.Run(filepath.ToSlash(file), func(t *testing.T) {
+			encoded, err := json.Marshal(feed)
+			require.NoError(t, err)
+			var got Feed
+			require.NoError(t, json.Unmarshal(encoded, &got))
+			assert.Equal(t, feed.FeedSource, got.FeedSource)
+
+			for item := range slices.Values(feed.GetItems()) {
+				encoded, err := json.Marshal(&item)
+				require.NoError(t, err)
+				var gotItem Item
+				require.NoError(t, json.Unmarshal(encoded, &gotItem))
+				assert.Equal(t, item.ItemSource, gotItem.ItemSource)
+			}
+		})
+	}
+
+	// Whether character data is written as CDATA is kept.
+	item := Item{SourceType: types.SourceTypeRSS, ItemSource: &rss.Item{
+		Title:          "CDATA",
+		Description:    rss.NewItemDescription("<p>Description</p>", true),
+		ContentEncoded: new(ext.NewContentEncoded("<p>Content</p>", true)),
+	}}
+	encoded, err := json.Marshal(&item)
+	require.NoError(t, err)
+	var got Item
+	require.NoError(t, json.Unmarshal(encoded, &got))
+	assert.Equal(t, item.ItemSource, got.ItemSource)
+}
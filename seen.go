@@ -0,0 +1,146 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SeenStore records which items have been seen (i.e., delivered to an application), by their key (see Item.Key), so
+// that feeds can be consumed incrementally with each item delivered once. MemorySeenStore is an in-memory
+// implementation; applications can implement the interface with Redis, SQL or another store to persist what has been
+// seen across restarts.
+//
+// Implementations must be safe for concurrent use.
+type SeenStore interface {
+	// Has reports whether the item with the given key has been seen.
+	Has(ctx context.Context, key string) (bool, error)
+	// MarkSeen records that the item with the given key has been seen, along with some metadata about it.
+	MarkSeen(ctx context.Context, key string, meta SeenMeta) error
+}
+
+// SeenMeta is metadata about an item recorded in a SeenStore.
+type SeenMeta struct {
+	// SeenAt is when the item was seen.
+	SeenAt time.Time `json:"seen_at"`
+	// FeedURL is the source URL of the feed the item was seen in, if known.
+	FeedURL string `json:"feed_url,omitempty"`
+	// Title is the title of the item.
+	Title string `json:"title,omitempty"`
+	// Link is the link of the item.
+	Link string `json:"link,omitempty"`
+}
+
+// ConsumeFunc is a function that consumes an item, such as by storing it or notifying a user of it, used with
+// Feed.ConsumeUnseen.
+type ConsumeFunc func(ctx context.Context, item Item) error
+
+// newSeenMeta creates the metadata recorded for the given item of the given feed.
+func newSeenMeta(feed *Feed, item *Item) SeenMeta {
+	return SeenMeta{
+		SeenAt:  time.Now().UTC(),
+		FeedURL: feed.GetSourceURL(),
+		Title:   item.GetTitle(),
+		Link:    item.GetLink(),
+	}
+}
+
+// UnseenItems returns the items of the Feed that have not been seen according to the given store. The store is not
+// modified; use ConsumeUnseen to also mark the items as seen.
+func (f *Feed) UnseenItems(ctx context.Context, store SeenStore) ([]Item, error) {
+	var items []Item
+	for item := range f.ItemsSeq() {
+		seen, err := store.Has(ctx, item.Key())
+		if err != nil {
+			return nil, fmt.Errorf("check seen item: %w", err)
+		}
+		if !seen {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// ConsumeUnseen calls the given function with each item of the Feed that has not been seen according to the given
+// store, in feed order, marking each item as seen once the function returns successfully. If the function returns an
+// error, consumption stops and the error is returned; the failed item and any remaining items are not marked as seen,
+// so they will be delivered again by the next call. Combined with a persistent store, this delivers each item exactly
+// once, as long as the function itself completes atomically. The number of items consumed is returned.
+//
+// Items with the same key are only delivered once, even if they appear in the Feed more than once.
+func (f *Feed) ConsumeUnseen(ctx context.Context, store SeenStore, consume ConsumeFunc) (int, error) {
+	var consumed int
+	for item := range f.ItemsSeq() {
+		if err := ctx.Err(); err != nil {
+			return consumed, err
+		}
+		key := item.Key()
+		seen, err := store.Has(ctx, key)
+		if err != nil {
+			return consumed, fmt.Errorf("check seen item: %w", err)
+		}
+		if seen {
+			continue
+		}
+		if err := consume(ctx, item); err != nil {
+			return consumed, err
+		}
+		if err := store.MarkSeen(ctx, key, newSeenMeta(f, &item)); err != nil {
+			return consumed, fmt.Errorf("mark item seen: %w", err)
+		}
+		consumed++
+	}
+	return consumed, nil
+}
+
+// MemorySeenStore is a SeenStore that holds seen items in memory. It is safe for concurrent use. The zero value is not
+// usable; create a MemorySeenStore with NewMemorySeenStore.
+type MemorySeenStore struct {
+	seen map[string]SeenMeta
+	mu   sync.RWMutex
+}
+
+var _ SeenStore = (*MemorySeenStore)(nil)
+
+// NewMemorySeenStore creates a new, empty, MemorySeenStore.
+func NewMemorySeenStore() *MemorySeenStore {
+	return &MemorySeenStore{seen: make(map[string]SeenMeta)}
+}
+
+// Has reports whether the item with the given key has been seen.
+func (s *MemorySeenStore) Has(_ context.Context, key string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, found := s.seen[key]
+	return found, nil
+}
+
+// MarkSeen records that the item with the given key has been seen. If it has already been seen, the metadata
+// recorded when it was first seen is kept.
+func (s *MemorySeenStore) MarkSeen(_ context.Context, key string, meta SeenMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, found := s.seen[key]; !found {
+		s.seen[key] = meta
+	}
+	return nil
+}
+
+// Get returns the metadata recorded for the item with the given key, if it has been seen.
+func (s *MemorySeenStore) Get(key string) (SeenMeta, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	meta, found := s.seen[key]
+	return meta, found
+}
+
+// Len returns the number of items that have been seen.
+func (s *MemorySeenStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.seen)
+}
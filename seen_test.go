@@ -0,0 +1,58 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+func TestFeedConsumeUnseen(t *testing.T) {
+	feed := newTestFeed(t, types.SourceTypeRSS)
+	store := NewMemorySeenStore()
+
+	unseen, err := feed.UnseenItems(t.Context(), store)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"First", "Second", "Third"}, itemTitles(unseen))
+
+	// A failure stops consumption, leaving the failed item and the rest unseen.
+	errConsume := errors.New("consume failed")
+	var consumed []Item
+	count, err := feed.ConsumeUnseen(t.Context(), store, func(_ context.Context, item Item) error {
+		if item.GetTitle() == "Second" {
+			return errConsume
+		}
+		consumed = append(consumed, item)
+		return nil
+	})
+	require.ErrorIs(t, err, errConsume)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"First"}, itemTitles(consumed))
+	assert.Equal(t, 1, store.Len())
+
+	// The next call delivers only the remaining items.
+	consumed = nil
+	count, err = feed.ConsumeUnseen(t.Context(), store, func(_ context.Context, item Item) error {
+		consumed = append(consumed, item)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"Second", "Third"}, itemTitles(consumed))
+
+	unseen, err = feed.UnseenItems(t.Context(), store)
+	require.NoError(t, err)
+	assert.Empty(t, unseen)
+
+	meta, found := store.Get(feed.GetItems()[0].Key())
+	require.True(t, found)
+	assert.Equal(t, "First", meta.Title)
+	assert.False(t, meta.SeenAt.IsZero())
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the Feed in the database as its JSON form. This allows a Feed to be stored
+// directly in a JSON (or text) column.
+func (f Feed) Value() (driver.Value, error) {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMarshal, err)
+	}
+	return data, nil
+}
+
+// Scan implements sql.Scanner, loading a Feed stored in its JSON form from the database.
+func (f *Feed) Scan(src any) error {
+	data, err := scanJSON(src)
+	if err != nil || data == nil {
+		return err
+	}
+	return f.UnmarshalJSON(data)
+}
+
+// Value implements driver.Valuer, storing the Item in the database as its JSON form. This allows an Item to be stored
+// directly in a JSON (or text) column.
+func (i Item) Value() (driver.Value, error) {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMarshal, err)
+	}
+	return data, nil
+}
+
+// Scan implements sql.Scanner, loading an Item stored in its JSON form from the database.
+func (i *Item) Scan(src any) error {
+	data, err := scanJSON(src)
+	if err != nil || data == nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// scanJSON returns the JSON data of the given database value. A NULL value returns nil data, leaving the destination
+// unchanged.
+func scanJSON(src any) ([]byte, error) {
+	switch value := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return value, nil
+	case string:
+		return []byte(value), nil
+	default:
+		return nil, fmt.Errorf("%w: cannot scan %T", ErrUnmarshal, src)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+var (
+	_ driver.Valuer = Feed{}
+	_ sql.Scanner   = (*Feed)(nil)
+	_ driver.Valuer = Item{}
+	_ sql.Scanner   = (*Item)(nil)
+)
+
+func TestFeedSQL(t *testing.T) {
+	feed := newTestFeed(t, types.SourceTypeAtom)
+
+	value, err := feed.Value()
+	require.NoError(t, err)
+
+	var got Feed
+	require.NoError(t, got.Scan(value))
+	assert.Equal(t, types.SourceTypeAtom, got.SourceType)
+	assert.Equal(t, feed.GetTitle(), got.GetTitle())
+
+	// Text columns are scanned as strings.
+	var fromString Feed
+	data, ok := value.([]byte)
+	require.True(t, ok)
+	require.NoError(t, fromString.Scan(string(data)))
+	assert.Equal(t, feed.GetTitle(), fromString.GetTitle())
+
+	var null Feed
+	require.NoError(t, null.Scan(nil))
+	assert.Nil(t, null.FeedSource)
+
+	require.ErrorIs(t, got.Scan(42), ErrUnmarshal)
+}
+
+func TestItemSQL(t *testing.T) {
+	item := newTestFeed(t, types.SourceTypeRSS).GetItems()[0]
+
+	value, err := item.Value()
+	require.NoError(t, err)
+
+	var got Item
+	require.NoError(t, got.Scan(value))
+	assert.Equal(t, types.SourceTypeRSS, got.SourceType)
+	assert.Equal(t, item.FeedTitle, got.FeedTitle)
+	assert.Equal(t, item.GetTitle(), got.GetTitle())
+}
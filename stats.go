@@ -0,0 +1,77 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"io"
+	"time"
+)
+
+// ParseStats are statistics about decoding a Feed, for finding feeds that are pathologically slow to decode and
+// tracking the performance of decoding with real data. See Feed.ParseStats.
+type ParseStats struct {
+	// Bytes is the number of bytes read to decode the Feed.
+	Bytes int64 `json:"bytes"`
+	// Items is the number of items decoded.
+	Items int `json:"items"`
+	// Decode is the time taken to decode the Feed, including detecting its format and any repairs (see WithRepair).
+	Decode time.Duration `json:"decode"`
+	// Validation is the time taken to validate the Feed when it was decoded, with the WithValidation option.
+	Validation time.Duration `json:"validation"`
+	// Sanitization is the total time taken to sanitize the descriptions and content of the items of the Feed. Values
+	// are sanitized as they are retrieved (e.g., by Item.GetDescription), not when decoding, so this grows as the Feed
+	// is used.
+	Sanitization time.Duration `json:"sanitization"`
+}
+
+// ParseStats returns statistics about decoding the Feed. For a Feed that was not decoded, such as one created with
+// NewFeedFromSource, only the Sanitization time is recorded.
+func (f *Feed) ParseStats() ParseStats {
+	var stats ParseStats
+	if f.stats != nil {
+		stats = *f.stats
+	}
+	if f.config != nil && f.config.sanitization != nil {
+		stats.Sanitization = time.Duration(f.config.sanitization.Load())
+	}
+	return stats
+}
+
+// finishDecode records the ParseStats of the decoded Feed, which was read from the given number of bytes since the
+// given time, and validates it if the WithValidation option was used.
+func (f *Feed) finishDecode(start time.Time, bytes int64) error {
+	f.stats = &ParseStats{
+		Bytes:  bytes,
+		Items:  len(f.FeedSource.GetItems()),
+		Decode: time.Since(start),
+	}
+	if !f.config.validate {
+		return nil
+	}
+	start = time.Now()
+	err := f.Validate()
+	f.stats.Validation = time.Since(start)
+	return err
+}
+
+// recordSanitization adds the time since the given start time to the total time taken to sanitize values.
+func (c *config) recordSanitization(start time.Time) {
+	if c == nil || c.sanitization == nil {
+		return
+	}
+	c.sanitization.Add(int64(time.Since(start)))
+}
+
+// countingReader is an io.Reader that counts the bytes read from the underlying io.Reader.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+// Read satisfies the io.Reader interface.
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedParseStats(t *testing.T) {
+	data := `<rss version="2.0"><channel><title>Example</title><link>https://example.com/</link>` +
+		`<description>Example feed</description>` +
+		`<item><title>One</title><link>https://example.com/1</link>` +
+		`<description><![CDATA[<p onclick="x()">One</p>]]></description></item>` +
+		`<item><title>Two</title><link>https://example.com/2</link><description>Two</description></item>` +
+		`</channel></rss>`
+	tests := map[string]struct {
+		options        []Option
+		wantValidation bool
+	}{
+		"default": {},
+		"with validation": {
+			options:        []Option{WithValidation()},
+			wantValidation: true,
+		},
+		"with repair": {
+			options: []Option{WithRepair()},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			options := append(tt.options, WithSanitizationPolicy(bluemonday.StrictPolicy()))
+			feed, err := NewFeedFromBytes([]byte(data), options...)
+			require.NoError(t, err)
+			stats := feed.ParseStats()
+			assert.Equal(t, int64(len(data)), stats.Bytes)
+			assert.Equal(t, 2, stats.Items)
+			assert.Positive(t, stats.Decode)
+			assert.Equal(t, tt.wantValidation, stats.Validation > 0)
+			assert.Zero(t, stats.Sanitization)
+
+			for item := range feed.ItemsSeq() {
+				item.GetDescription()
+			}
+			assert.Positive(t, feed.ParseStats().Sanitization)
+			assert.Equal(t, stats.Decode, feed.Clone().ParseStats().Decode)
+		})
+	}
+
+	t.Run("source", func(t *testing.T) {
+		feed, err := NewFeedFromBytes([]byte(data))
+		require.NoError(t, err)
+		assert.Zero(t, NewFeedFromSource(feed.FeedSource).ParseStats().Bytes)
+	})
+}
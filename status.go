@@ -0,0 +1,80 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+)
+
+// ErrorClass is the class of an error fetching a feed, which indicates how a subscription manager should react to it.
+// See ClassifyError.
+type ErrorClass string
+
+const (
+	// ErrorClassNone is the class of no error.
+	ErrorClassNone ErrorClass = ""
+	// ErrorClassTransient indicates the fetch may succeed if it is tried again later, such as after a network error, a
+	// timeout, a server error or rate limiting.
+	ErrorClassTransient ErrorClass = "transient"
+	// ErrorClassPermanent indicates the fetch is unlikely to succeed if it is tried again, such as when the feed was
+	// not found, the certificate of the server could not be verified or the response is not a feed. A single 404 may
+	// be a mistake, so subscription managers should only unsubscribe after several in a row (see feedstate.State).
+	ErrorClassPermanent ErrorClass = "permanent"
+	// ErrorClassGone indicates the server reported the feed is gone for good (410 Gone), so it should be unsubscribed.
+	ErrorClassGone ErrorClass = "gone"
+	// ErrorClassAuthRequired indicates the server requires authentication, or refused access, to fetch the feed.
+	ErrorClassAuthRequired ErrorClass = "auth_required"
+)
+
+// StatusError indicates the server responded to a request for a feed with an error status. It is wrapped by the
+// ErrFetch errors returned by NewFeedFromURL and Feed.Refresh.
+type StatusError struct {
+	// Status is the status line of the response, e.g., "404 Not Found".
+	Status string
+	// StatusCode is the status code of the response.
+	StatusCode int
+}
+
+// Error satisfies the Error interface.
+func (e *StatusError) Error() string {
+	return e.Status
+}
+
+// Class returns the class of the error, based on the status code.
+func (e *StatusError) Class() ErrorClass {
+	switch {
+	case e.StatusCode == http.StatusGone:
+		return ErrorClassGone
+	case e.StatusCode == http.StatusUnauthorized, e.StatusCode == http.StatusForbidden,
+		e.StatusCode == http.StatusProxyAuthRequired:
+		return ErrorClassAuthRequired
+	case e.StatusCode == http.StatusRequestTimeout, e.StatusCode == http.StatusTooEarly,
+		e.StatusCode == http.StatusTooManyRequests, e.StatusCode >= http.StatusInternalServerError:
+		return ErrorClassTransient
+	default:
+		return ErrorClassPermanent
+	}
+}
+
+// ClassifyError returns the class of the given error from fetching a feed with NewFeedFromURL or Feed.Refresh. Errors
+// with an error status are classified by the status code (see StatusError). Errors verifying the certificate of the
+// server, or decoding the response as a feed, are permanent. Any other errors, such as network errors and timeouts, are
+// transient. A nil error, or ErrNotModified, has no class.
+func ClassifyError(err error) ErrorClass {
+	if err == nil || errors.Is(err, ErrNotModified) {
+		return ErrorClassNone
+	}
+	if statusErr, ok := errors.AsType[*StatusError](err); ok {
+		return statusErr.Class()
+	}
+	if _, ok := errors.AsType[*tls.CertificateVerificationError](err); ok {
+		return ErrorClassPermanent
+	}
+	if errors.Is(err, ErrParseBytes) || errors.Is(err, ErrUnmarshal) || errors.Is(err, ErrFeedTooLarge) {
+		return ErrorClassPermanent
+	}
+	return ErrorClassTransient
+}
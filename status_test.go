@@ -0,0 +1,70 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{name: "no error", want: ErrorClassNone},
+		{name: "not modified", err: ErrNotModified, want: ErrorClassNone},
+		{name: "network error", err: fmt.Errorf("%w: %w", ErrFetch, errors.New("connection refused")),
+			want: ErrorClassTransient},
+		{name: "timeout", err: fmt.Errorf("%w: %w", ErrFetch, context.DeadlineExceeded), want: ErrorClassTransient},
+		{name: "not a feed", err: fmt.Errorf("%w: %w", ErrParseBytes, errors.New("EOF")), want: ErrorClassPermanent},
+		{name: "too large", err: ErrFeedTooLarge, want: ErrorClassPermanent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyError(tt.err))
+		})
+	}
+}
+
+func TestFetchStatusErrorClass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code, err := strconv.Atoi(r.URL.Query().Get("status"))
+		if err != nil {
+			code = http.StatusBadRequest
+		}
+		w.WriteHeader(code)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[int]ErrorClass{
+		http.StatusGone:                ErrorClassGone,
+		http.StatusNotFound:            ErrorClassPermanent,
+		http.StatusBadRequest:          ErrorClassPermanent,
+		http.StatusUnauthorized:        ErrorClassAuthRequired,
+		http.StatusForbidden:           ErrorClassAuthRequired,
+		http.StatusTooManyRequests:     ErrorClassTransient,
+		http.StatusInternalServerError: ErrorClassTransient,
+		http.StatusServiceUnavailable:  ErrorClassTransient,
+	}
+	for code, want := range tests {
+		t.Run(strconv.Itoa(code), func(t *testing.T) {
+			_, err := NewFeedFromURL(context.Background(), server.URL+"/?status="+strconv.Itoa(code))
+			require.ErrorIs(t, err, ErrFetch)
+			statusErr, ok := errors.AsType[*StatusError](err)
+			require.True(t, ok)
+			assert.Equal(t, code, statusErr.StatusCode)
+			assert.Equal(t, want, ClassifyError(err))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// DecodeItems will decode the items of a feed of the given format from the given io.Reader, yielding each item as it
+// is decoded. Unlike NewDecoder, the whole feed is never held in memory, which makes it suitable for very large feeds.
+// Only the items (and the feed title, if it appears before the items) are decoded; any other feed-level data is
+// skipped. A byte order mark is handled as for NewFeedFromBytes. If an error occurs, it is yielded and decoding stops.
+// Options can be passed to configure the items.
+func DecodeItems(r io.Reader, format types.SourceType, options ...Option) iter.Seq2[Item, error] {
+	cfg := newConfig(options...)
+	return func(yield func(Item, error) bool) {
+		if cfg.maxItems > 0 {
+			// Stop decoding once enough items have been yielded.
+			yieldItem, count := yield, 0
+			yield = func(item Item, err error) bool {
+				if err != nil {
+					return yieldItem(item, err)
+				}
+				count++
+				return yieldItem(item, nil) && count < cfg.maxItems
+			}
+		}
+		data, err := decodeBOM(r)
+		if err != nil {
+			yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+			return
+		}
+		switch format {
+		case types.SourceTypeRSS:
+			decodeXMLItems[*rss.Item](data, format, "channel", "item", cfg, yield)
+		case types.SourceTypeRDF:
+			decodeXMLItems[*rdf.Item](data, format, "RDF", "item", cfg, yield)
+		case types.SourceTypeAtom:
+			decodeXMLItems[*atom.Entry](data, format, "feed", "entry", cfg, yield)
+		case types.SourceTypeJSONFeed:
+			decodeJSONItems(data, cfg, yield)
+		default:
+			yield(Item{}, fmt.Errorf("%w: unsupported format %q", ErrParseBytes, format))
+		}
+	}
+}
+
+// decodeXMLItems decodes each element with the given item name that is a child of an element with the given parent
+// name into the item type T, yielding each as an Item.
+func decodeXMLItems[T interface {
+	*E
+	types.ItemSource
+}, E any](r io.Reader, format types.SourceType, parent, name string, cfg *config, yield func(Item, error) bool,
+) {
+	decoder, release := newXMLDecoder("", r, cfg)
+	defer release()
+
+	var (
+		elements  []string
+		feedTitle string
+	)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+			return
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			var current string
+			if len(elements) > 0 {
+				current = elements[len(elements)-1]
+			}
+			switch {
+			case current == parent && element.Name.Local == name:
+				item := T(new(E))
+				if err := decoder.DecodeElement(item, &element); err != nil {
+					yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+					return
+				}
+				if !yield(newItem(item, format, feedTitle, cfg), nil) {
+					return
+				}
+			case (current == "channel" || current == "feed") && element.Name.Local == "title" && feedTitle == "":
+				var title struct {
+					Value string `xml:",chardata"`
+				}
+				if err := decoder.DecodeElement(&title, &element); err != nil {
+					yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+					return
+				}
+				feedTitle = title.Value
+			default:
+				elements = append(elements, element.Name.Local)
+			}
+		case xml.EndElement:
+			if len(elements) > 0 {
+				elements = elements[:len(elements)-1]
+			}
+		}
+	}
+}
+
+// decodeJSONItems decodes each entry in the items array of a JSONFeed, yielding each as an Item.
+func decodeJSONItems(r io.Reader, cfg *config, yield func(Item, error) bool) {
+	decoder := json.NewDecoder(cfg.limitReader(r))
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		yield(Item{}, err)
+		return
+	}
+	var feedTitle string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+			return
+		}
+		switch token {
+		case "items":
+			if err := expectDelim(decoder, '['); err != nil {
+				yield(Item{}, err)
+				return
+			}
+			for decoder.More() {
+				item := &jsonfeed.Item{}
+				if err := decoder.Decode(item); err != nil {
+					yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+					return
+				}
+				if !yield(newItem(item, types.SourceTypeJSONFeed, feedTitle, cfg), nil) {
+					return
+				}
+			}
+			if err := expectDelim(decoder, ']'); err != nil {
+				yield(Item{}, err)
+				return
+			}
+		case "title":
+			if err := decoder.Decode(&feedTitle); err != nil {
+				yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+				return
+			}
+		default:
+			// Skip any other feed-level values.
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				yield(Item{}, fmt.Errorf("%w: %w", ErrParseBytes, err))
+				return
+			}
+		}
+	}
+}
+
+// expectDelim reads the next token from the decoder and returns an error if it is not the given delimiter.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrParseBytes, err)
+	}
+	if token != delim {
+		return fmt.Errorf("%w: expected %q, found %v", ErrParseBytes, delim, token)
+	}
+	return nil
+}
+
+// newItem creates an Item from the given source.
+func newItem(source types.ItemSource, format types.SourceType, feedTitle string, cfg *config) Item {
+	return Item{
+		ItemSource: source,
+		SourceType: format,
+		FeedTitle:  feedTitle,
+		config:     cfg,
+	}
+}
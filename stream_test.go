@@ -0,0 +1,138 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+var streamTests = map[types.SourceType]string{
+	types.SourceTypeRSS: `<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>Example Feed</title>
+    <link>https://example.com/</link>
+    <description>An example feed</description>
+    <image><title>Not the feed title</title><url>https://example.com/a.png</url></image>
+    <item><title>First</title><link>https://example.com/1</link><dc:creator>Alice</dc:creator></item>
+    <item><title>Second</title><link>https://example.com/2</link></item>
+    <item><title>Third</title><link>https://example.com/3</link></item>
+  </channel>
+</rss>`,
+	types.SourceTypeAtom: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example Feed</title>
+  <id>urn:uuid:60a76c80-d399-11d9-b93C-0003939e0af6</id>
+  <updated>2003-12-13T18:30:02Z</updated>
+  <entry>
+    <title>First</title><link href="https://example.com/1"/><id>urn:1</id><updated>2003-12-13T18:30:02Z</updated>
+    <source><title>Not the feed title</title></source>
+  </entry>
+  <entry><title>Second</title><link href="https://example.com/2"/><id>urn:2</id><updated>2003-12-13T18:30:02Z</updated></entry>
+  <entry><title>Third</title><link href="https://example.com/3"/><id>urn:3</id><updated>2003-12-13T18:30:02Z</updated></entry>
+</feed>`,
+	types.SourceTypeRDF: `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/">
+  <channel rdf:about="https://example.com/">
+    <title>Example Feed</title>
+    <link>https://example.com/</link>
+    <description>An example feed</description>
+  </channel>
+  <item rdf:about="https://example.com/1"><title>First</title><link>https://example.com/1</link></item>
+  <item rdf:about="https://example.com/2"><title>Second</title><link>https://example.com/2</link></item>
+  <item rdf:about="https://example.com/3"><title>Third</title><link>https://example.com/3</link></item>
+</rdf:RDF>`,
+	types.SourceTypeJSONFeed: `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Example Feed",
+  "home_page_url": "https://example.com/",
+  "authors": [{"name": "Alice"}],
+  "items": [
+    {"id": "1", "title": "First", "url": "https://example.com/1", "content_text": "One"},
+    {"id": "2", "title": "Second", "url": "https://example.com/2", "content_text": "Two"},
+    {"id": "3", "title": "Third", "url": "https://example.com/3", "content_text": "Three"}
+  ]
+}`,
+}
+
+func TestDecodeItems(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			var titles, links []string
+			for item, err := range DecodeItems(strings.NewReader(data), format) {
+				require.NoError(t, err)
+				assert.Equal(t, format, item.SourceType)
+				assert.Equal(t, "Example Feed", item.FeedTitle)
+				titles = append(titles, item.GetTitle())
+				links = append(links, item.GetLink())
+			}
+			assert.Equal(t, []string{"First", "Second", "Third"}, titles)
+			assert.Equal(t, []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, links)
+		})
+	}
+}
+
+func TestDecodeItemsBOM(t *testing.T) {
+	for format, data := range streamTests {
+		encodings := map[string][]byte{
+			"utf-8":    append(bytes.Clone(utf8BOM), data...),
+			"utf-16le": encodeUTF16(binary.LittleEndian, utf16LEBOM, data),
+			"utf-16be": encodeUTF16(binary.BigEndian, utf16BEBOM, data),
+		}
+		for encoding, encoded := range encodings {
+			t.Run(string(format)+"/"+encoding, func(t *testing.T) {
+				var titles []string
+				for item, err := range DecodeItems(bytes.NewReader(encoded), format) {
+					require.NoError(t, err)
+					titles = append(titles, item.GetTitle())
+				}
+				assert.Equal(t, []string{"First", "Second", "Third"}, titles)
+			})
+		}
+	}
+}
+
+func TestDecodeItemsStop(t *testing.T) {
+	for format, data := range streamTests {
+		t.Run(string(format), func(t *testing.T) {
+			var count int
+			for _, err := range DecodeItems(strings.NewReader(data), format) {
+				require.NoError(t, err)
+				count++
+				break
+			}
+			assert.Equal(t, 1, count)
+		})
+	}
+}
+
+func TestDecodeItemsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		format types.SourceType
+	}{
+		{name: "unsupported format", data: "<html></html>", format: types.SourceTypeHTML},
+		{name: "invalid json", data: `{"items": [{"id": 1`, format: types.SourceTypeJSONFeed},
+		{name: "not a json object", data: `["items"]`, format: types.SourceTypeJSONFeed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs []error
+			for _, err := range DecodeItems(strings.NewReader(tt.data), tt.format) {
+				errs = append(errs, err)
+			}
+			require.Len(t, errs, 1)
+			assert.ErrorIs(t, errs[0], ErrParseBytes)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"slices"
+	"time"
+)
+
+// TLSInfo describes the TLS connection a feed was fetched over, and the certificate of the server, for diagnosing
+// feeds with expiring or invalid certificates. See Health.TLS.
+type TLSInfo struct {
+	// NotBefore is the time the certificate of the server is valid from.
+	NotBefore time.Time `json:"not_before"`
+	// NotAfter is the time the certificate of the server expires.
+	NotAfter time.Time `json:"not_after"`
+	// Err is the error verifying the certificate of the server, if it could not be verified.
+	Err error `json:"-"`
+	// Version is the TLS version of the connection (e.g., "TLS 1.3"), if a connection was established.
+	Version string `json:"version,omitempty"`
+	// CipherSuite is the name of the cipher suite of the connection, if a connection was established.
+	CipherSuite string `json:"cipher_suite,omitempty"`
+	// ServerName is the name of the server the certificate was verified against.
+	ServerName string `json:"server_name,omitempty"`
+	// Subject is the subject of the certificate of the server.
+	Subject string `json:"subject"`
+	// Issuer is the issuer of the certificate of the server.
+	Issuer string `json:"issuer"`
+	// DNSNames are the names the certificate of the server is valid for.
+	DNSNames []string `json:"dns_names,omitempty"`
+	// Verified reports whether the certificate of the server could be verified.
+	Verified bool `json:"verified"`
+}
+
+// ExpiresWithin reports whether the certificate of the server expires within the given duration from now, or has
+// already expired.
+func (i *TLSInfo) ExpiresWithin(d time.Duration) bool {
+	return time.Until(i.NotAfter) < d
+}
+
+// newTLSInfo creates a TLSInfo from the given TLS connection state. If the certificate of the server was not verified
+// when the connection was established, such as with the WithInsecureTLS option, it is verified now against the system
+// roots. If the connection has no certificates, nil is returned.
+func newTLSInfo(state *tls.ConnectionState) *TLSInfo {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	info := certificateInfo(state.PeerCertificates[0], state.ServerName)
+	info.Version = tls.VersionName(state.Version)
+	info.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	info.Verified = len(state.VerifiedChains) > 0
+	if !info.Verified {
+		info.Err = verifyCertificates(state.PeerCertificates, state.ServerName)
+		info.Verified = info.Err == nil
+	}
+	return info
+}
+
+// newTLSInfoFromError creates a TLSInfo from the given error, if it is an error verifying the certificate of the server
+// with the given name. Otherwise, nil is returned.
+func newTLSInfoFromError(err error, serverName string) *TLSInfo {
+	certErr, ok := errors.AsType[*tls.CertificateVerificationError](err)
+	if !ok || len(certErr.UnverifiedCertificates) == 0 {
+		return nil
+	}
+	info := certificateInfo(certErr.UnverifiedCertificates[0], serverName)
+	info.Err = certErr
+	return info
+}
+
+// certificateInfo creates a TLSInfo describing the given certificate of the server with the given name.
+func certificateInfo(cert *x509.Certificate, serverName string) *TLSInfo {
+	return &TLSInfo{
+		NotBefore:  cert.NotBefore,
+		NotAfter:   cert.NotAfter,
+		ServerName: serverName,
+		Subject:    cert.Subject.String(),
+		Issuer:     cert.Issuer.String(),
+		DNSNames:   slices.Clone(cert.DNSNames),
+	}
+}
+
+// verifyCertificates verifies the given certificate chain of the server with the given name against the system roots,
+// as a TLS client would. The error is a *tls.CertificateVerificationError, as it would be for a client.
+func verifyCertificates(certs []*x509.Certificate, serverName string) error {
+	intermediates := x509.NewCertPool()
+	for cert := range slices.Values(certs[1:]) {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{DNSName: serverName, Intermediates: intermediates})
+	if err != nil {
+		return &tls.CertificateVerificationError{UnverifiedCertificates: certs, Err: err}
+	}
+	return nil
+}
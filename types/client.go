@@ -0,0 +1,14 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package types
+
+import "github.com/go-resty/resty/v2"
+
+// UserAgent is the User-Agent header sent with requests made by the default HTTP client.
+const UserAgent = "go-syndication"
+
+// NewClient returns the default HTTP client, used to make requests when no other client is given.
+func NewClient() *resty.Client {
+	return resty.New().SetHeader("User-Agent", UserAgent)
+}
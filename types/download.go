@@ -0,0 +1,153 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for image.DecodeConfig
+	_ "image/jpeg" // register JPEG for image.DecodeConfig
+	_ "image/png"  // register PNG for image.DecodeConfig
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultMaxImageBytes is the largest image ImageInfo.Download will download, if no other maximum is given.
+const DefaultMaxImageBytes = 10 << 20
+
+var (
+	// ErrImageDownload indicates an image could not be downloaded.
+	ErrImageDownload = errors.New("image download failed")
+	// ErrImageTooLarge indicates an image was larger than the maximum size allowed.
+	ErrImageTooLarge = errors.New("image too large")
+	// ErrNotImage indicates downloaded data was not an image.
+	ErrNotImage = errors.New("not an image")
+)
+
+// DownloadedImage is an image downloaded with ImageInfo.Download.
+type DownloadedImage struct {
+	// Data is the data of the image.
+	Data []byte
+	// MimeType is the mimetype of the image, as sniffed from its data.
+	MimeType string
+	// Width is the width of the image in pixels, if known.
+	Width int
+	// Height is the height of the image in pixels, if known.
+	Height int
+}
+
+// Download downloads the image, for example to cache the icon of a feed. At most maxBytes bytes are read (or
+// DefaultMaxImageBytes, if maxBytes is not positive); larger images return an ErrImageTooLarge error. The mimetype of
+// the image is sniffed from its data rather than trusted from the response, and data that is not an image returns an
+// ErrNotImage error. SVG images are only recognized if the server declared them as such. The width and height of PNG,
+// JPEG, GIF and WebP images are read from their headers.
+//
+// The given client is used to download the image. If it is nil, a default client is used.
+func (i *ImageInfo) Download(ctx context.Context, client *resty.Client, maxBytes int64) (*DownloadedImage, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxImageBytes
+	}
+	if client == nil {
+		client = NewClient()
+	}
+	resp, err := client.R().SetContext(ctx).SetDoNotParseResponse(true).Get(i.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImageDownload, err)
+	}
+	defer resp.RawBody().Close()
+	if resp.IsError() {
+		return nil, fmt.Errorf("%w: %s", ErrImageDownload, resp.Status())
+	}
+	if resp.RawResponse.ContentLength > maxBytes {
+		return nil, fmt.Errorf("%w: %d bytes exceeds maximum of %d bytes", ErrImageTooLarge,
+			resp.RawResponse.ContentLength, maxBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.RawBody(), maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImageDownload, err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%w: exceeds maximum of %d bytes", ErrImageTooLarge, maxBytes)
+	}
+
+	mimeType := sniffImageType(data, resp.Header().Get("Content-Type"))
+	if mimeType == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotImage, http.DetectContentType(data))
+	}
+	img := &DownloadedImage{Data: data, MimeType: mimeType}
+	img.Width, img.Height = imageDimensions(data, mimeType)
+	return img, nil
+}
+
+// sniffImageType returns the mimetype of the given image data, or an empty string if it is not an image. SVG images
+// are text, so they are only recognized if the given declared content type is that of SVG.
+func sniffImageType(data []byte, contentType string) string {
+	sniffed, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if strings.HasPrefix(sniffed, "image/") {
+		return sniffed
+	}
+	declared, _, err := mime.ParseMediaType(contentType)
+	if err == nil && declared == "image/svg+xml" && bytes.Contains(data, []byte("<svg")) {
+		return declared
+	}
+	return ""
+}
+
+// imageDimensions returns the width and height of the given image data, read from its header, or zero if they cannot
+// be read.
+func imageDimensions(data []byte, mimeType string) (int, int) {
+	switch mimeType {
+	case "image/png", "image/jpeg", "image/gif":
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return 0, 0
+		}
+		return config.Width, config.Height
+	case "image/webp":
+		return webpDimensions(data)
+	default:
+		return 0, 0
+	}
+}
+
+// webpDimensions returns the width and height of the given WebP image data, read from the header of its first chunk,
+// which is either a VP8 (lossy), VP8L (lossless) or VP8X (extended) chunk.
+func webpDimensions(data []byte) (int, int) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0
+	}
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// The frame header starts with a 3 byte frame tag and 3 byte start code, followed by 14 bit dimensions.
+		if !bytes.Equal(data[23:26], []byte{0x9d, 0x01, 0x2a}) {
+			return 0, 0
+		}
+		width := binary.LittleEndian.Uint16(data[26:28]) & 0x3fff
+		height := binary.LittleEndian.Uint16(data[28:30]) & 0x3fff
+		return int(width), int(height)
+	case "VP8L":
+		// A signature byte, followed by 14 bit dimensions minus one.
+		if data[20] != 0x2f {
+			return 0, 0
+		}
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1
+	case "VP8X":
+		// Flags and reserved bytes, followed by 24 bit dimensions minus one.
+		width := uint32(data[24]) | uint32(data[25])<<8 | uint32(data[26])<<16
+		height := uint32(data[27]) | uint32(data[28])<<8 | uint32(data[29])<<16
+		return int(width) + 1, int(height) + 1
+	default:
+		return 0, 0
+	}
+}
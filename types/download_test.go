@@ -0,0 +1,71 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package types
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageInfoDownload(t *testing.T) {
+	var pngData bytes.Buffer
+	require.NoError(t, png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 32, 16))))
+	// A VP8X (extended) WebP header for a 640x480 image.
+	webpData := append([]byte("RIFF\x16\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00"),
+		0x7f, 0x02, 0x00, 0xdf, 0x01, 0x00)
+	svgData := []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" width="1" height="1"></svg>`)
+
+	mux := http.NewServeMux()
+	serve := func(path, contentType string, data []byte) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write(data)
+		})
+	}
+	// The PNG is served with the wrong content type, which is ignored.
+	serve("/icon.png", "text/plain", pngData.Bytes())
+	serve("/icon.webp", "image/webp", webpData)
+	serve("/icon.svg", "image/svg+xml", svgData)
+	serve("/page.html", "image/png", []byte("<html><body>Not found</body></html>"))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		path       string
+		maxBytes   int64
+		wantType   string
+		wantWidth  int
+		wantHeight int
+		wantErr    error
+	}{
+		{path: "/icon.png", wantType: "image/png", wantWidth: 32, wantHeight: 16},
+		{path: "/icon.webp", wantType: "image/webp", wantWidth: 640, wantHeight: 480},
+		{path: "/icon.svg", wantType: "image/svg+xml"},
+		{path: "/page.html", wantErr: ErrNotImage},
+		{path: "/icon.png", maxBytes: 10, wantErr: ErrImageTooLarge},
+		{path: "/missing.png", wantErr: ErrImageDownload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			info := &ImageInfo{URL: server.URL + tt.path}
+			img, err := info.Download(t.Context(), nil, tt.maxBytes)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, img.MimeType)
+			assert.Equal(t, tt.wantWidth, img.Width)
+			assert.Equal(t, tt.wantHeight, img.Height)
+			assert.NotEmpty(t, img.Data)
+		})
+	}
+}
@@ -3,7 +3,10 @@
 
 package types
 
-import "time"
+import (
+	"iter"
+	"time"
+)
 
 // ObjectMetadata contains methods for retrieving the metadata information about the Object.
 type ObjectMetadata interface {
@@ -24,11 +27,31 @@ type HasMedia interface {
 	GetImage() *ImageInfo
 }
 
+// HasIcon contains methods for retrieving a small icon for an Object, distinct from its (usually larger) image. Not
+// all formats define an icon.
+type HasIcon interface {
+	GetIcon() *ImageInfo
+}
+
+// HasComments contains methods for retrieving the location of the discussion of an Object. Not all formats define
+// one.
+type HasComments interface {
+	GetCommentsURL() string
+}
+
 // MediaEditable indicates that the media of the object can be changed.
 type MediaEditable interface {
 	SetImage(image *ImageInfo)
 }
 
+// MetadataEditable indicates that the metadata of the object can be changed.
+type MetadataEditable interface {
+	SetTitle(title string)
+	SetDescription(description string)
+	SetLink(link string)
+	SetPublishedDate(date time.Time)
+}
+
 // HasAttribution contains methods for retrieving values that relate to the copyright, rights, authors and
 // contributors of an Object.
 type HasAttribution interface {
@@ -47,6 +70,11 @@ type HasTaxonomy interface {
 	GetCategories() []string
 }
 
+// TaxonomyEditable indicates that categories can be added to the object.
+type TaxonomyEditable interface {
+	AddCategory(category string)
+}
+
 // HasLocalization contains methods for retrieving localization information of an Object.
 type HasLocalization interface {
 	GetLanguage() *string
@@ -74,6 +102,8 @@ type ObjectCommon interface {
 // ItemSource is an abstraction representing an individual Item from any type of Feed source.
 type ItemSource interface {
 	ObjectCommon
+	MetadataEditable
+	TaxonomyEditable
 	HasID
 	HasContent
 }
@@ -84,7 +114,13 @@ type FeedSource interface {
 	Source
 	SourceEditable
 	MediaEditable
+	MetadataEditable
+	TaxonomyEditable
 	GetUpdateInterval() time.Duration
 	GetItems() []ItemSource
+	// AddItem appends the given item to the feed. The item must be of the item type of the feed's format (e.g., an
+	// *rss.Item for an RSS feed), otherwise an ErrIncompatibleItem error is returned.
+	AddItem(item ItemSource) error
+	ItemsSeq() iter.Seq[ItemSource]
 	Validate() error
 }
@@ -60,5 +60,20 @@ type ImageInfo struct {
 	URL string `json:"url" validate:"required,url" xml:",chardata"`
 }
 
+// Pagination is an abstraction of the links between the pages of a paginated feed across different types of specifications.
+type Pagination struct {
+	// First is the URL of the first page of the feed.
+	First string `json:"first,omitempty,omitzero" validate:"omitempty,url"`
+
+	// Last is the URL of the last page of the feed.
+	Last string `json:"last,omitempty,omitzero" validate:"omitempty,url"`
+
+	// Next is the URL of the next page of the feed.
+	Next string `json:"next,omitempty,omitzero" validate:"omitempty,url"`
+
+	// Previous is the URL of the previous page of the feed.
+	Previous string `json:"previous,omitempty,omitzero" validate:"omitempty,url"`
+}
+
 // SourceType is the type of source the feed or object came from. This can be used with abstractions that generalize different feed types into a common format to preserve information on the original.
 type SourceType string
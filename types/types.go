@@ -5,9 +5,14 @@
 package types
 
 import (
+	"errors"
 	"slices"
 )
 
+// ErrIncompatibleItem indicates an item could not be added to a feed, as it is not of the item type of the feed's
+// format.
+var ErrIncompatibleItem = errors.New("item is not compatible with feed")
+
 var (
 	// MimeTypesRSS contains canonical/standard mimetypes for RSS feeds.
 	MimeTypesRSS = []string{"application/rss+xml", "application/rdf+xml"}
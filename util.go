@@ -4,10 +4,12 @@
 package feeds
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/immanent-tech/go-syndication/atom"
 	"github.com/immanent-tech/go-syndication/rdf"
@@ -15,11 +17,62 @@ import (
 	"golang.org/x/net/html/charset"
 )
 
+// readerPool is a pool of buffered readers for decoding. Reusing the readers avoids allocating a new read buffer for
+// every feed decoded.
+var readerPool = sync.Pool{
+	New: func() any {
+		return bufio.NewReader(nil)
+	},
+}
+
+// bufferedReader returns a buffered io.ByteReader for the given io.Reader, to be used by an xml.Decoder. If the
+// io.Reader is already an io.ByteReader, it is returned as-is. Otherwise, a buffered reader is retrieved from the pool.
+// The returned function must be called to return the buffered reader to the pool once decoding is complete.
+func bufferedReader(rd io.Reader) (io.Reader, func()) {
+	if _, ok := rd.(io.ByteReader); ok {
+		return rd, func() {}
+	}
+	reader, ok := readerPool.Get().(*bufio.Reader)
+	if !ok {
+		reader = bufio.NewReader(nil)
+	}
+	reader.Reset(rd)
+	return reader, func() {
+		reader.Reset(nil)
+		readerPool.Put(reader)
+	}
+}
+
 // Decode will decode the byte array into the given type T, and assign values without a namespace with the given
 // namespace.
 func Decode[T any](namespace string, rd io.Reader) (T, error) {
+	rd, err := decodeBOM(rd)
+	if err != nil {
+		var feed T
+		return feed, err
+	}
+	return decode[T](namespace, rd, &config{})
+}
+
+// decode will decode the byte array into the given type T, using the given config.
+func decode[T any](namespace string, rd io.Reader, cfg *config) (T, error) {
 	var feed T
 
+	decoder, release := newXMLDecoder(namespace, rd, cfg)
+	defer release()
+
+	if err := decoder.Decode(&feed); err != nil {
+		return feed, fmt.Errorf("could not decode byte array: %w", err)
+	}
+
+	return feed, nil
+}
+
+// newXMLDecoder creates an xml.Decoder for the given io.Reader, applying any limits in the config. Values without a
+// namespace are assigned the given namespace. The returned function must be called once decoding is complete.
+func newXMLDecoder(namespace string, rd io.Reader, cfg *config) (*xml.Decoder, func()) {
+	rd, release := bufferedReader(cfg.limitReader(rd))
+
 	decoder := xml.NewDecoder(rd)
 	decoder.Strict = false // be lenient with malformed feeds in the wild
 
@@ -27,11 +80,21 @@ func Decode[T any](namespace string, rd io.Reader) (T, error) {
 		decoder.DefaultSpace = namespace
 	}
 	decoder.CharsetReader = charset.NewReaderLabel
-	if err := decoder.Decode(&feed); err != nil {
-		return feed, fmt.Errorf("could not decode byte array: %w", err)
-	}
 
-	return feed, nil
+	var tokens xml.TokenReader = decoder
+	if cfg.maxTokens > 0 {
+		// Wrap the decoder to stop reading once the token budget has been exceeded.
+		tokens = &tokenLimiter{tokens: tokens, max: cfg.maxTokens}
+	}
+	if cfg.maxItems > 0 {
+		// Wrap the decoder to stop reading once enough items have been decoded.
+		tokens = newItemLimiter(tokens, cfg.maxItems)
+	}
+	if tokens != decoder {
+		decoder = xml.NewTokenDecoder(tokens)
+		decoder.Strict = false
+	}
+	return decoder, release
 }
 
 // Encode will encode the given type T into a byte array.
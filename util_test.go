@@ -0,0 +1,38 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// plainReader hides any io.ByteReader implementation of the wrapped reader, like a network response body would.
+type plainReader struct {
+	io.Reader
+}
+
+func BenchmarkDecode(b *testing.B) {
+	data := streamTests[types.SourceTypeRSS]
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Decode[*rss.RSS]("", plainReader{strings.NewReader(data)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewDecoder(b *testing.B) {
+	data := streamTests[types.SourceTypeRSS]
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := NewDecoder[*rss.RSS](plainReader{strings.NewReader(data)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"mime"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
 var ErrInvalidField = errors.New("invalid field")
@@ -26,6 +29,9 @@ func init() {
 	if err := validate.RegisterValidation("rfc3066lang", validateRFC3066Lang); err != nil {
 		panic(err)
 	}
+	if err := validate.RegisterValidation("nohtml", validateNoHTML); err != nil {
+		panic(err)
+	}
 }
 
 // FieldError is a particular validation error on a particular field.
@@ -130,3 +136,44 @@ func validateRFC3066Lang(fl validator.FieldLevel) bool {
 	}
 	return true
 }
+
+// htmlElements are the known HTML element names. Only tags for these elements are considered markup, so that text such
+// as "List<T>" or "Map<K, V>" is not mistaken for HTML.
+var htmlElements = []atom.Atom{
+	atom.A, atom.Abbr, atom.Address, atom.Area, atom.Article, atom.Aside, atom.Audio, atom.B, atom.Base, atom.Bdi,
+	atom.Bdo, atom.Big, atom.Blockquote, atom.Body, atom.Br, atom.Button, atom.Canvas, atom.Caption, atom.Center,
+	atom.Cite, atom.Code, atom.Col, atom.Colgroup, atom.Data, atom.Datalist, atom.Dd, atom.Del, atom.Details, atom.Dfn,
+	atom.Dialog, atom.Div, atom.Dl, atom.Dt, atom.Em, atom.Embed, atom.Fieldset, atom.Figcaption, atom.Figure,
+	atom.Font, atom.Footer, atom.Form, atom.Frame, atom.Frameset, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+	atom.Head, atom.Header, atom.Hgroup, atom.Hr, atom.Html, atom.I, atom.Iframe, atom.Img, atom.Input, atom.Ins,
+	atom.Kbd, atom.Label, atom.Legend, atom.Li, atom.Link, atom.Main, atom.Map, atom.Mark, atom.Menu, atom.Meta,
+	atom.Meter, atom.Nav, atom.Noscript, atom.Object, atom.Ol, atom.Optgroup, atom.Option, atom.Output, atom.P,
+	atom.Param, atom.Picture, atom.Pre, atom.Progress, atom.Q, atom.Rp, atom.Rt, atom.Ruby, atom.S, atom.Samp,
+	atom.Script, atom.Section, atom.Select, atom.Small, atom.Source, atom.Span, atom.Strike, atom.Strong, atom.Style,
+	atom.Sub, atom.Summary, atom.Sup, atom.Svg, atom.Table, atom.Tbody, atom.Td, atom.Template, atom.Textarea,
+	atom.Tfoot, atom.Th, atom.Thead, atom.Time, atom.Title, atom.Tr, atom.Track, atom.Tt, atom.U, atom.Ul, atom.Var,
+	atom.Video, atom.Wbr,
+}
+
+// ContainsHTML reports whether the given value contains HTML markup (i.e., any tags for known HTML elements). The value
+// is expected to be decoded, so markup that was escaped (e.g., "&lt;b&gt;") or in a CDATA section is found, but
+// entities that remain are not considered markup.
+func ContainsHTML(value string) bool {
+	tokenizer := html.NewTokenizer(strings.NewReader(value))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return false
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if slices.Contains(htmlElements, atom.Lookup(name)) {
+				return true
+			}
+		}
+	}
+}
+
+// validateNoHTML checks that the value does not contain HTML markup, for elements that may only contain plain text.
+func validateNoHTML(fl validator.FieldLevel) bool {
+	return !ContainsHTML(fl.Field().String())
+}
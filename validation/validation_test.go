@@ -0,0 +1,33 @@
+// Copyright 2025 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	AGPL-3.0-or-later
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsHTML(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{name: "plain text", value: "Invalid name", want: false},
+		{name: "less than", value: "a < b and c > d", want: false},
+		{name: "bold", value: "<b>Invalid name</b>", want: true},
+		{name: "end tag", value: "Invalid name</b>", want: true},
+		{name: "self-closing", value: "Invalid<br/>name", want: true},
+		{name: "uppercase", value: "<P>Invalid name", want: true},
+		{name: "generic type", value: "Using List<T> in Java", want: false},
+		{name: "generic map", value: "Map<K, V> explained", want: false},
+		{name: "escaped", value: "&lt;b&gt;Invalid name&lt;/b&gt;", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ContainsHTML(tt.value))
+		})
+	}
+}
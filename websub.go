@@ -0,0 +1,139 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rss"
+	"github.com/immanent-tech/go-syndication/types"
+)
+
+// webSubHubTitle is the title of the hubs of a JSON Feed, which describes the protocol used to talk to them.
+const webSubHubTitle = "WebSub"
+
+// ErrWebSub indicates an error occurred linking a feed to, or notifying, a WebSub hub.
+var ErrWebSub = errors.New("unable to publish feed to WebSub hub")
+
+// SetWebSubLinks links the Feed to the given WebSub hubs, so that subscribers can discover them. The rel="self" link of
+// the Feed is set to the given topic URL, which is the URL the feed is published at, and any existing rel="hub" links
+// are replaced with links to the given hubs:
+//
+//   - Atom: <link rel="self"> and <link rel="hub"> elements.
+//   - RSS: <atom:link rel="self"> and <atom:link rel="hub"> elements of the channel.
+//   - JSONFeed: the feed_url, and a hub for each hub, titled "WebSub".
+//
+// RDF feeds cannot link to hubs, so an ErrWebSub error is returned for them.
+func (f *Feed) SetWebSubLinks(topic string, hubs ...string) error {
+	switch source := f.FeedSource.(type) {
+	case *atom.Feed:
+		source.Links = slices.DeleteFunc(source.Links, func(link atom.Link) bool {
+			return isWebSubRel(string(link.Rel))
+		})
+		source.Links = append(source.Links, atom.Link{
+			Href: topic,
+			Rel:  atom.LinkRelSelf,
+			Type: new(types.MimeTypesAtom[0]),
+		})
+		for hub := range slices.Values(hubs) {
+			source.Links = append(source.Links, atom.Link{Href: hub, Rel: atom.LinkRelHub})
+		}
+	case *rss.RSS:
+		source.Channel.AtomLinks = slices.DeleteFunc(source.Channel.AtomLinks, func(link rss.AtomLink) bool {
+			return isWebSubRel(string(link.Rel))
+		})
+		source.Channel.AtomLinks = append(source.Channel.AtomLinks, atom.Link{
+			Href: topic,
+			Rel:  atom.LinkRelSelf,
+			Type: new(types.MimeTypesRSS[0]),
+		})
+		for hub := range slices.Values(hubs) {
+			source.Channel.AtomLinks = append(source.Channel.AtomLinks, atom.Link{Href: hub, Rel: atom.LinkRelHub})
+		}
+	case *jsonfeed.Feed:
+		source.FeedURL = &topic
+		source.Hubs = nil
+		for hub := range slices.Values(hubs) {
+			source.Hubs = append(source.Hubs, jsonfeed.Hub{Title: webSubHubTitle, URL: hub})
+		}
+	default:
+		return fmt.Errorf("%w: %s feeds cannot link to hubs", ErrWebSub, f.SourceType)
+	}
+	return nil
+}
+
+// PublishWebSub notifies the WebSub hubs of the Feed that it has been updated, so that they fetch it and distribute
+// the update to subscribers. It should be called after the updated feed has been published at its topic URL. A publish
+// request (a form POST with hub.mode=publish and hub.url set to the topic URL) is sent to each rel="hub" link of the
+// Feed, for its rel="self" link, as set by SetWebSubLinks. Options can be passed to configure the HTTP client, timeout
+// and logger used for the requests.
+//
+// All the hubs are notified, even if some fail. An ErrWebSub error is returned if the Feed has no self or hub links, or
+// wrapping the errors of any hubs that could not be notified.
+func (f *Feed) PublishWebSub(ctx context.Context, options ...Option) error {
+	self := f.GetLinksByRel(LinkRelSelf)
+	if len(self) == 0 {
+		return fmt.Errorf("%w: feed has no self link", ErrWebSub)
+	}
+	hubs := f.GetLinksByRel(LinkRelHub)
+	if len(hubs) == 0 {
+		return fmt.Errorf("%w: feed has no hub links", ErrWebSub)
+	}
+
+	cfg := f.config.with(options...)
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+	client := cfg.httpClient()
+	logger := cfg.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var errs []error
+	for hub := range slices.Values(hubs) {
+		if err := publishWebSub(ctx, client, hub.Href, self[0].Href); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		logger.DebugContext(ctx, "Notified WebSub hub.",
+			slog.String("hub", hub.Href),
+			slog.String("topic", self[0].Href))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrWebSub, errors.Join(errs...))
+	}
+	return nil
+}
+
+// publishWebSub sends a publish request for the given topic URL to the given hub.
+func publishWebSub(ctx context.Context, client *resty.Client, hub, topic string) error {
+	resp, err := client.R().
+		SetContext(ctx).
+		SetFormData(map[string]string{"hub.mode": "publish", "hub.url": topic}).
+		Post(hub)
+	if err != nil {
+		return fmt.Errorf("%s: %w", hub, err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s: %s", hub, resp.Status())
+	}
+	return nil
+}
+
+// isWebSubRel reports whether the given link relation is one set by SetWebSubLinks.
+func isWebSubRel(rel string) bool {
+	rel = normalizeLinkRel(rel)
+	return rel == LinkRelSelf || rel == LinkRelHub
+}
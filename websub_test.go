@@ -0,0 +1,100 @@
+// Copyright 2026 Joshua Rich <joshua.rich@gmail.com>.
+// SPDX-License-Identifier: 	MIT
+
+package feeds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immanent-tech/go-syndication/atom"
+	"github.com/immanent-tech/go-syndication/jsonfeed"
+	"github.com/immanent-tech/go-syndication/rdf"
+	"github.com/immanent-tech/go-syndication/rss"
+)
+
+func TestFeedSetWebSubLinks(t *testing.T) {
+	const topic = "https://example.com/feed"
+	hubs := []string{"https://hub.example.com/", "https://pubsubhubbub.example.org/"}
+
+	sources := map[string]*Feed{
+		"atom": NewFeedFromSource(&atom.Feed{
+			Title: atom.Title{Value: "Example"},
+			Links: atom.Links{
+				{Href: "https://example.com/", Rel: atom.LinkRelAlternate},
+				{Href: "https://old.example.com/feed", Rel: atom.LinkRelSelf},
+				{Href: "https://old-hub.example.com/", Rel: atom.LinkRelHub},
+			},
+		}),
+		"rss": NewFeedFromSource(rss.NewRSS("Example", "Example", "https://example.com/",
+			rss.WithAtomLink(&atom.Link{Href: "https://old-hub.example.com/", Rel: atom.LinkRelHub}))),
+		"jsonfeed": NewFeedFromSource(&jsonfeed.Feed{
+			Title:       "Example",
+			HomePageURL: new("https://example.com/"),
+			Hubs:        []jsonfeed.Hub{{Title: "rssCloud", URL: "https://old-hub.example.com/"}},
+		}),
+	}
+	for name, feed := range sources {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, feed.SetWebSubLinks(topic, hubs...))
+			assert.Equal(t, topic, feed.GetSourceURL())
+			var hubLinks []string
+			for link := range slices.Values(feed.GetLinksByRel(LinkRelHub)) {
+				hubLinks = append(hubLinks, link.Href)
+			}
+			assert.Equal(t, hubs, hubLinks)
+			require.Len(t, feed.GetAlternateLinks(), 1)
+			assert.Equal(t, "https://example.com/", feed.GetAlternateLinks()[0].Href)
+		})
+	}
+
+	// The links are written out with the feed.
+	encoded, err := Encode(sources["rss"].FeedSource)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `href="https://hub.example.com/" rel="hub"`)
+
+	err = NewFeedFromSource(&rdf.RDF{}).SetWebSubLinks(topic, hubs...)
+	require.ErrorIs(t, err, ErrWebSub)
+}
+
+func TestFeedPublishWebSub(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "publish", r.PostForm.Get("hub.mode"))
+		mu.Lock()
+		received = append(received, r.PostForm.Get("hub.url"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(hub.Close)
+
+	feed := NewFeedFromSource(rss.NewRSS("Example", "Example", "https://example.com/"))
+	require.ErrorIs(t, feed.PublishWebSub(context.Background()), ErrWebSub)
+
+	require.NoError(t, feed.SetWebSubLinks("https://example.com/feed.xml", hub.URL+"/a", hub.URL+"/b"))
+	require.NoError(t, feed.PublishWebSub(context.Background()))
+	assert.Equal(t, []string{"https://example.com/feed.xml", "https://example.com/feed.xml"}, received)
+
+	received = nil
+	require.NoError(t, feed.SetWebSubLinks("https://example.com/feed.xml", hub.URL+"/broken", hub.URL+"/a"))
+	err := feed.PublishWebSub(context.Background())
+	require.ErrorIs(t, err, ErrWebSub)
+	assert.Contains(t, err.Error(), "/broken")
+	assert.Equal(t, []string{"https://example.com/feed.xml"}, received)
+}